@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/diff"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// runDiff implements the diff subcommand and returns the process exit code:
+// 0 if there are no breaking changes, 1 if there are, 2 on usage or input errors.
+func runDiff(args []string) int {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to YAML config file used for both schemas")
+	oldConfigPath := flags.String("old-config", "", "Path to YAML config file for the old schema (defaults to -config)")
+	format := flags.String("format", "text", "Output format: text or json")
+
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  gql2j diff [flags] old.graphql new.graphql\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "Error: invalid format %q (valid: text, json)\n", *format)
+		return 2
+	}
+
+	newCfg, err := loadDiffConfig(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		return 2
+	}
+	oldCfg := newCfg
+	if *oldConfigPath != "" {
+		if oldCfg, err = loadDiffConfig(*oldConfigPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+			return 2
+		}
+	}
+
+	p := parser.NewParser()
+	oldSchema, err := p.ParseFile(flags.Arg(0))
+	if err != nil {
+		renderErrors(err)
+		return 2
+	}
+	newSchema, err := p.ParseFile(flags.Arg(1))
+	if err != nil {
+		renderErrors(err)
+		return 2
+	}
+
+	report := diff.Compare(oldSchema, newSchema, oldCfg, newCfg)
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+	} else {
+		printDiff(os.Stdout, report)
+	}
+
+	if report.HasBreaking() {
+		return 1
+	}
+	return 0
+}
+
+// loadDiffConfig loads the configuration that determines Java names and
+// scalar mappings. No schema path is required.
+func loadDiffConfig(configPath string) (*config.Config, error) {
+	cfg, err := loadConfiguration(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// printDiff prints one line per change followed by a summary.
+func printDiff(w io.Writer, report *diff.Report) {
+	if len(report.Changes) == 0 {
+		fmt.Fprintln(w, "No changes")
+		return
+	}
+
+	for _, change := range report.Changes {
+		fmt.Fprintf(w, "%-10s %s: %s [%s]\n", change.Severity, change.Path, change.Message, change.Kind)
+	}
+
+	fmt.Fprintf(w, "\n%d breaking, %d dangerous, %d safe\n",
+		report.Summary.Breaking, report.Summary.Dangerous, report.Summary.Safe)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// runDirectives implements the directives subcommand, which prints the SDL
+// definitions of the gql2j directives, and returns the process exit code.
+func runDirectives(args []string) int {
+	flags := flag.NewFlagSet("directives", flag.ExitOnError)
+
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  gql2j directives > gql2j-directives.graphql\n\n")
+		fmt.Fprintf(os.Stderr, "Prints the definitions of the gql2j directives for inclusion in a schema.\n")
+	}
+
+	flags.Parse(args)
+
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	fmt.Printf("# gql2j directives, generated by gql2j %s\n\n", toolVersion)
+	fmt.Print(parser.DirectivesSDL())
+	return 0
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+)
+
+// Output formats for errors and findings.
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatSARIF = "sarif"
+)
+
+// checkFormat returns an error if format is not a supported output format.
+func checkFormat(format string) error {
+	switch format {
+	case formatText, formatJSON, formatSARIF:
+		return nil
+	}
+	return fmt.Errorf("invalid format %q (valid: %s, %s, %s)", format, formatText, formatJSON, formatSARIF)
+}
+
+// diagnosticsReport is the document written by -format json.
+type diagnosticsReport struct {
+	Diagnostics []*errors.Diagnostic `json:"diagnostics"`
+}
+
+// collectDiagnostics converts errors into diagnostics.
+func collectDiagnostics(errs ...error) []*errors.Diagnostic {
+	diagnostics := []*errors.Diagnostic{}
+	for _, err := range errs {
+		diagnostics = append(diagnostics, errors.Diagnostics(err)...)
+	}
+	return diagnostics
+}
+
+// collectWarnings converts warnings into diagnostics with warning severity,
+// or error severity if warnings are treated as errors.
+func collectWarnings(warnings []error, asErrors bool) []*errors.Diagnostic {
+	diagnostics := collectDiagnostics(warnings...)
+	if !asErrors {
+		for _, d := range diagnostics {
+			d.Severity = "warning"
+		}
+	}
+	return diagnostics
+}
+
+// writeDiagnostics writes the diagnostics as JSON or SARIF.
+func writeDiagnostics(w io.Writer, format string, diagnostics []*errors.Diagnostic) error {
+	var document interface{}
+	switch format {
+	case formatJSON:
+		document = diagnosticsReport{Diagnostics: diagnostics}
+	case formatSARIF:
+		document = errors.NewSARIFLog("gql2j", toolVersion, diagnostics)
+	default:
+		return fmt.Errorf("format %q has no structured output", format)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(document)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/lint"
+)
+
+// runLint implements the lint subcommand and returns the process exit code:
+// 0 if there are no error-level findings, 1 otherwise.
+func runLint(args []string) int {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to YAML config file")
+	schemaPath := flags.String("schema", "", "GraphQL schema path, SDL or introspection JSON; - reads stdin (overrides config)")
+	format := flags.String("format", formatText, "Output format: text, json or sarif")
+
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  gql2j lint [flags] [schema.graphql]\n\n")
+		fmt.Fprintf(os.Stderr, "Rules (severity configured under lint.rules):\n")
+		for _, rule := range sortedRules() {
+			fmt.Fprintf(os.Stderr, "  %s\n", rule)
+		}
+		fmt.Fprintf(os.Stderr, "\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	flags.Parse(args)
+
+	if err := checkFormat(*format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	structured := *format != formatText
+
+	opts := &cliOptions{
+		configPath: *configPath,
+		schemaPath: *schemaPath,
+	}
+	if flags.NArg() > 0 {
+		opts.schemaPath = flags.Arg(0)
+	}
+
+	cfg, err := prepareConfig(opts)
+	if err != nil {
+		if structured {
+			writeDiagnostics(os.Stdout, *format, collectDiagnostics(err))
+		} else {
+			printError(err)
+		}
+		return 1
+	}
+
+	schema, err := parseSchema(cfg)
+	if err != nil {
+		if structured {
+			writeDiagnostics(os.Stdout, *format, collectDiagnostics(err))
+		} else {
+			renderErrors(err)
+		}
+		return 1
+	}
+
+	findings := lint.NewLinter(&cfg.Lint).Lint(schema)
+	if structured {
+		errs := make([]error, len(findings))
+		for i, f := range findings {
+			errs[i] = f
+		}
+		if err := writeDiagnostics(os.Stdout, *format, collectDiagnostics(errs...)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	} else {
+		printFindings(os.Stdout, findings)
+	}
+
+	if lint.HasErrors(findings) {
+		return 1
+	}
+	return 0
+}
+
+// printFindings prints one line per finding followed by a summary.
+func printFindings(w io.Writer, findings []*errors.LintError) {
+	counts := make(map[string]int)
+	for _, f := range findings {
+		counts[f.Severity]++
+		if f.Location != nil {
+			fmt.Fprintf(w, "%s: ", f.Location)
+		}
+		fmt.Fprintf(w, "%s: %s [%s]\n", f.Severity, f.Message, f.Rule)
+	}
+
+	if len(findings) == 0 {
+		fmt.Fprintln(w, "No problems found")
+		return
+	}
+	fmt.Fprintf(w, "\n%d error(s), %d warning(s), %d info\n",
+		counts[config.SeverityError], counts[config.SeverityWarning], counts[config.SeverityInfo])
+}
+
+func sortedRules() []string {
+	var rules []string
+	for rule := range config.DefaultLintRules() {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	return rules
+}
@@ -3,21 +3,35 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
 	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
 	"github.com/source-c/go-gql2j/internal/generator"
 	"github.com/source-c/go-gql2j/internal/output"
-	"github.com/source-c/go-gql2j/internal/parser"
 )
 
 func main() {
+	// Dispatch subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lint":
+			os.Exit(runLint(os.Args[2:]))
+		case "diff":
+			os.Exit(runDiff(os.Args[2:]))
+		case "directives":
+			os.Exit(runDirectives(os.Args[2:]))
+		}
+	}
+
 	// Define flags
 	configPath := flag.String("config", "", "Path to YAML config file")
-	schemaPath := flag.String("schema", "", "GraphQL schema path (overrides config)")
+	schemaPath := flag.String("schema", "", "GraphQL schema path, SDL or introspection JSON; - reads stdin (overrides config)")
 	outputDir := flag.String("output", "", "Output directory (overrides config)")
 	packageName := flag.String("package", "", "Java package name (overrides config)")
+	target := flag.String("target", "", "Target language: java or kotlin (overrides config)")
 	javaVersion := flag.Int("java-version", 0, "Target Java version: 8, 11, 17, 21")
 	lombok := flag.Bool("lombok", false, "Enable Lombok annotations")
 	lombokDisable := flag.Bool("lombok-disable", false, "Disable Lombok annotations")
@@ -27,13 +41,23 @@ func main() {
 	clean := flag.Bool("clean", false, "Clean output directory before generating")
 	verbose := flag.Bool("verbose", false, "Enable verbose output")
 	version := flag.Bool("version", false, "Print version information")
+	watch := flag.Bool("watch", false, "Watch schema and config files and regenerate on changes")
+	format := flag.String("format", formatText, "Error output format: text, json or sarif")
+	werror := flag.Bool("Werror", false, "Treat warnings as errors")
 
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "gql2j - GraphQL to Java code generator\n\n")
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		fmt.Fprintf(os.Stderr, "  gql2j -schema schema.graphql -output ./generated -package com.example.model\n")
 		fmt.Fprintf(os.Stderr, "  gql2j -config gql2j.yaml\n")
-		fmt.Fprintf(os.Stderr, "  gql2j -config gql2j.yaml -java-version 8 -lombok=false\n\n")
+		fmt.Fprintf(os.Stderr, "  gql2j -config gql2j.yaml -java-version 8 -lombok=false\n")
+		fmt.Fprintf(os.Stderr, "  gql2j -config gql2j.yaml -target kotlin\n")
+		fmt.Fprintf(os.Stderr, "  gql2j -config gql2j.yaml -watch\n")
+		fmt.Fprintf(os.Stderr, "  gql2j -config gql2j.yaml -format sarif > gql2j.sarif\n")
+		fmt.Fprintf(os.Stderr, "  curl ... | gql2j -schema - -output ./generated\n")
+		fmt.Fprintf(os.Stderr, "  gql2j lint [-config gql2j.yaml] [-format sarif] [schema.graphql]\n")
+		fmt.Fprintf(os.Stderr, "  gql2j diff [-config gql2j.yaml] [-format json] old.graphql new.graphql\n")
+		fmt.Fprintf(os.Stderr, "  gql2j directives > gql2j-directives.graphql\n\n")
 		fmt.Fprintf(os.Stderr, "Flags:\n")
 		flag.PrintDefaults()
 	}
@@ -42,65 +66,78 @@ func main() {
 
 	// Handle version flag
 	if *version {
-		fmt.Println("gql2j version 1.0.1")
+		fmt.Printf("gql2j version %s\n", toolVersion)
 		os.Exit(0)
 	}
 
-	// Load configuration
-	cfg, err := loadConfiguration(*configPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+	if err := checkFormat(*format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
-	// Apply flag overrides
-	applyOverrides(cfg, *schemaPath, *outputDir, *packageName, *javaVersion,
-		*lombok, *lombokDisable, *validation, *validationDisable, *validationPkg)
+	// With structured output the document goes to stdout and progress to stderr
+	structured := *format != formatText
+	out := io.Writer(os.Stdout)
+	if structured {
+		out = os.Stderr
+	}
 
-	// Validate we have required settings
-	if cfg.Schema.Path == "" && *schemaPath == "" {
-		fmt.Fprintf(os.Stderr, "Error: schema path is required\n")
-		fmt.Fprintf(os.Stderr, "Use -schema flag or specify in config file\n")
+	// fail reports a fatal error in the selected format and exits
+	fail := func(prefix string, err error) {
+		if structured {
+			writeDiagnostics(os.Stdout, *format, collectDiagnostics(err))
+		} else if prefix != "" {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", prefix, err)
+		} else {
+			printError(err)
+		}
 		os.Exit(1)
 	}
 
-	// Use schema from flag if provided
-	if *schemaPath != "" {
-		cfg.Schema.Path = *schemaPath
+	opts := &cliOptions{
+		configPath:        *configPath,
+		schemaPath:        *schemaPath,
+		outputDir:         *outputDir,
+		packageName:       *packageName,
+		target:            *target,
+		javaVersion:       *javaVersion,
+		lombok:            *lombok,
+		lombokDisable:     *lombokDisable,
+		validation:        *validation,
+		validationDisable: *validationDisable,
+		validationPkg:     *validationPkg,
+		verbose:           *verbose,
 	}
 
-	// Resolve paths relative to config file if using config
-	if *configPath != "" {
-		configDir := filepath.Dir(*configPath)
-		if err := cfg.ResolvePaths(configDir); err != nil {
-			fmt.Fprintf(os.Stderr, "Error resolving paths: %v\n", err)
-			os.Exit(1)
-		}
+	cfg, err := prepareConfig(opts)
+	if err != nil {
+		fail("", err)
 	}
 
-	// Validate configuration
-	if err := cfg.Validate(); err != nil {
-		fmt.Fprintf(os.Stderr, "Configuration error: %v\n", err)
-		os.Exit(1)
+	if *watch {
+		if structured {
+			fmt.Fprintf(os.Stderr, "Error: -format %s cannot be combined with -watch\n", *format)
+			os.Exit(1)
+		}
+		if err := runWatch(opts, cfg, *clean); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
 	}
 
 	// Parse the schema
 	if *verbose {
-		fmt.Printf("Parsing schema: %s\n", cfg.Schema.Path)
-	}
-
-	p := parser.NewParser()
-	var schema *parser.Schema
-
-	if len(cfg.Schema.Includes) > 0 {
-		schema, err = p.ParseWithIncludes(cfg.Schema.Path, cfg.Schema.Includes)
-	} else {
-		schema, err = p.ParseFile(cfg.Schema.Path)
+		fmt.Fprintf(out, "Parsing schema: %s\n", cfg.Schema.Path)
 	}
 
+	schema, err := parseSchema(cfg)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing schema: %v\n", err)
-		os.Exit(1)
+		if !structured {
+			renderErrors(err)
+			os.Exit(1)
+		}
+		fail("", err)
 	}
 
 	// Create output directory and optionally clean
@@ -108,84 +145,188 @@ func main() {
 
 	if *clean {
 		if *verbose {
-			fmt.Printf("Cleaning output directory: %s\n", cfg.Output.Directory)
+			fmt.Fprintf(out, "Cleaning output directory: %s\n", cfg.Output.Directory)
 		}
 		if err := writer.Clean(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error cleaning output directory: %v\n", err)
-			os.Exit(1)
+			fail("Error cleaning output directory", err)
 		}
 	}
 
 	if err := writer.EnsureDir(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
-		os.Exit(1)
+		fail("Error creating output directory", err)
 	}
 
 	// Generate code
 	if *verbose {
-		fmt.Printf("Generating Java code to: %s\n", cfg.Output.Directory)
-		fmt.Printf("Package: %s\n", cfg.Output.Package)
-		fmt.Printf("Java version: %d\n", cfg.Java.Version)
+		fmt.Fprintf(out, "Generating Java code to: %s\n", cfg.Output.Directory)
+		fmt.Fprintf(out, "Package: %s\n", cfg.Output.Package)
+		fmt.Fprintf(out, "Java version: %d\n", cfg.Java.Version)
 		if cfg.Features.Lombok.Enabled {
-			fmt.Println("Lombok: enabled")
+			fmt.Fprintln(out, "Lombok: enabled")
 		}
 		if cfg.Features.Validation.Enabled {
-			fmt.Printf("Validation: enabled (%s)\n", cfg.Features.Validation.Package)
+			fmt.Fprintf(out, "Validation: enabled (%s)\n", cfg.Features.Validation.Package)
 		}
 	}
 
 	gen := generator.NewGenerator(cfg)
-	files, err := gen.Generate(schema)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating code: %v\n", err)
+	genResult := gen.GenerateWithResult(schema)
+	files := genResult.Files
+	genErrs := errors.NewErrorCollection()
+	for _, err := range genResult.Errors {
+		genErrs.Add(err)
+	}
+	genErr := genErrs.ToError()
+	if genErr != nil && !structured {
+		renderErrors(genResult.Errors...)
 		// Continue to write what we can
 	}
+	if !structured {
+		printWarnings(genResult.Warnings)
+	}
 
 	// Write files
 	result := writer.WriteAllWithResult(files)
 
 	// Report results
 	for _, path := range result.Written {
-		fmt.Printf("Generated: %s\n", path)
+		fmt.Fprintf(out, "Generated: %s\n", path)
 	}
 
 	for _, path := range result.Skipped {
-		fmt.Printf("Skipped: %s\n", path)
+		fmt.Fprintf(out, "Skipped: %s\n", path)
 	}
 
-	for _, err := range result.Errors {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if !structured {
+		for _, err := range result.Errors {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
 	}
 
 	// Print summary
 	stats := generator.GetStats(files, result.Errors)
-	fmt.Printf("\nGeneration complete: %d classes, %d interfaces, %d enums\n",
+	fmt.Fprintf(out, "\nGeneration complete: %d classes, %d interfaces, %d enums\n",
 		stats.Classes, stats.Interfaces, stats.Enums)
 
+	if structured {
+		diagnostics := collectDiagnostics(append([]error{genErr}, result.Errors...)...)
+		diagnostics = append(diagnostics, collectWarnings(genResult.Warnings, *werror)...)
+		if err := writeDiagnostics(os.Stdout, *format, diagnostics); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if genErr != nil {
+			os.Exit(1)
+		}
+	}
+
 	if stats.ErrorCount > 0 {
-		fmt.Printf("%d error(s) occurred\n", stats.ErrorCount)
+		fmt.Fprintf(out, "%d error(s) occurred\n", stats.ErrorCount)
+		os.Exit(1)
+	}
+	if genErr != nil {
 		os.Exit(1)
 	}
+
+	if len(genResult.Warnings) > 0 {
+		fmt.Fprintf(out, "%d warning(s)\n", len(genResult.Warnings))
+		if *werror {
+			fmt.Fprintf(os.Stderr, "Error: warnings treated as errors (-Werror)\n")
+			os.Exit(1)
+		}
+	}
+}
+
+// toolVersion is the gql2j version reported by -version and in SARIF logs.
+const toolVersion = "1.0.1"
+
+// stdinPath is the schema path that selects standard input.
+const stdinPath = "-"
+
+// cliOptions holds the command line flags that affect configuration.
+type cliOptions struct {
+	configPath        string
+	schemaPath        string
+	outputDir         string
+	packageName       string
+	target            string
+	javaVersion       int
+	lombok            bool
+	lombokDisable     bool
+	validation        bool
+	validationDisable bool
+	validationPkg     string
+	verbose           bool
+}
+
+// prepareConfig loads the configuration, applies flag overrides, resolves
+// paths and validates the result. The returned error is ready to be printed.
+func prepareConfig(opts *cliOptions) (*config.Config, error) {
+	// Load configuration
+	cfg, err := loadConfiguration(opts.configPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading configuration: %w", err)
+	}
+
+	// Apply flag overrides
+	applyOverrides(cfg, opts.schemaPath, opts.outputDir, opts.packageName, opts.target, opts.javaVersion,
+		opts.lombok, opts.lombokDisable, opts.validation, opts.validationDisable, opts.validationPkg)
+
+	// Validate we have required settings
+	if cfg.Schema.Path == "" {
+		return nil, fmt.Errorf("Error: %w",
+			errors.NewConfigError("schema path is required", nil).WithField("schema.path").
+				WithHint("use the -schema flag or set schema.path in the config file"))
+	}
+
+	// Resolve paths relative to config file if using config
+	if opts.configPath != "" {
+		schemaPath := cfg.Schema.Path
+		configDir := filepath.Dir(opts.configPath)
+		if err := cfg.ResolvePaths(configDir); err != nil {
+			return nil, fmt.Errorf("Error resolving paths: %w", err)
+		}
+		if schemaPath == stdinPath {
+			cfg.Schema.Path = stdinPath
+		}
+	}
+
+	// Validate configuration
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("Configuration error: %w", err)
+	}
+
+	return cfg, nil
 }
 
 func loadConfiguration(configPath string) (*config.Config, error) {
+	if path := findConfigPath(configPath); path != "" {
+		return config.Load(path)
+	}
+
+	// Return default configuration
+	return config.DefaultConfig(), nil
+}
+
+// findConfigPath returns the explicit config path, or the first default
+// config file found in the current directory, or "" if there is none.
+func findConfigPath(configPath string) string {
 	if configPath != "" {
-		return config.Load(configPath)
+		return configPath
 	}
 
 	// Try to find a config file in the current directory
 	defaultPaths := []string{"gql2j.yaml", "gql2j.yml", ".gql2j.yaml", ".gql2j.yml"}
 	for _, path := range defaultPaths {
 		if _, err := os.Stat(path); err == nil {
-			return config.Load(path)
+			return path
 		}
 	}
 
-	// Return default configuration
-	return config.DefaultConfig(), nil
+	return ""
 }
 
-func applyOverrides(cfg *config.Config, schemaPath, outputDir, packageName string,
+func applyOverrides(cfg *config.Config, schemaPath, outputDir, packageName, target string,
 	javaVersion int, lombok, lombokDisable, validation, validationDisable bool, validationPkg string) {
 
 	if schemaPath != "" {
@@ -197,6 +338,9 @@ func applyOverrides(cfg *config.Config, schemaPath, outputDir, packageName strin
 	if packageName != "" {
 		cfg.Output.Package = packageName
 	}
+	if target != "" {
+		cfg.Target = target
+	}
 	if javaVersion != 0 {
 		cfg.Java.Version = javaVersion
 	}
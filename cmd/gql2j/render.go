@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// stdinName is the source name of a schema read from standard input.
+const stdinName = "<stdin>"
+
+// stdinSource keeps the schema read from standard input, which cannot be
+// read again to show source lines in diagnostics.
+var stdinSource []byte
+
+// readSource returns the content of a schema source for rendering diagnostics.
+func readSource(file string) ([]byte, error) {
+	if file == stdinName && stdinSource != nil {
+		return stdinSource, nil
+	}
+	return os.ReadFile(file)
+}
+
+// parseSchema parses the schema file and its includes. A schema path of "-"
+// reads SDL or introspection JSON from standard input.
+func parseSchema(cfg *config.Config) (*parser.Schema, error) {
+	p := parser.NewParser()
+	if cfg.Schema.Path == stdinPath {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, errors.NewParseError("failed to read schema", err).
+				WithLocation(&errors.Location{File: stdinName})
+		}
+		stdinSource = data
+		return p.ParseReaderWithIncludes(bytes.NewReader(data), stdinName, cfg.Schema.Includes)
+	}
+	if len(cfg.Schema.Includes) > 0 {
+		return p.ParseWithIncludes(cfg.Schema.Path, cfg.Schema.Includes)
+	}
+	return p.ParseFile(cfg.Schema.Path)
+}
+
+// renderErrors prints errors to stderr with the offending schema line and a
+// hint where available.
+func renderErrors(errs ...error) {
+	errors.Render(os.Stderr, collectDiagnostics(errs...), readSource)
+}
+
+// printError prints an error that is not rendered as a diagnostic, such as
+// a configuration error, followed by its hint.
+func printError(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	for _, d := range errors.Diagnostics(err) {
+		if d.Hint != "" {
+			fmt.Fprintf(os.Stderr, "  = hint: %s\n", d.Hint)
+		}
+	}
+}
+
+// printWarnings prints warnings to stderr in text format.
+func printWarnings(warnings []error) {
+	errors.Render(os.Stderr, collectWarnings(warnings, false), readSource)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/generator"
+	"github.com/source-c/go-gql2j/internal/output"
+	"github.com/source-c/go-gql2j/internal/parser"
+	"github.com/source-c/go-gql2j/internal/watch"
+)
+
+// watchSession keeps the state carried between regenerations in watch mode.
+type watchSession struct {
+	opts       *cliOptions
+	cfg        *config.Config
+	configPath string
+	writer     *output.IncrementalWriter
+	schema     *parser.Schema
+	fileNames  map[string]string // GraphQL type name -> generated file name
+	aggregates map[string]bool   // files derived from the whole schema
+}
+
+// runWatch generates once and then regenerates whenever the schema, one of
+// its includes or the config file changes. Errors are reported but never end
+// the session; it runs until interrupted.
+func runWatch(opts *cliOptions, cfg *config.Config, clean bool) error {
+	if cfg.Schema.Path == stdinPath {
+		return fmt.Errorf("watch mode cannot read the schema from standard input")
+	}
+
+	s := &watchSession{
+		opts:       opts,
+		cfg:        cfg,
+		configPath: findConfigPath(opts.configPath),
+		fileNames:  make(map[string]string),
+		aggregates: make(map[string]bool),
+	}
+	s.writer = output.NewIncrementalWriter(cfg.Output.Directory)
+
+	if clean {
+		if err := s.writer.Clean(); err != nil {
+			return err
+		}
+	}
+
+	s.regenerate(false)
+
+	w := watch.NewWatcher(s.patterns())
+	if opts.verbose {
+		for _, path := range w.Files() {
+			fmt.Printf("Watching: %s\n", path)
+		}
+	}
+	fmt.Println("Watching for changes (press Ctrl+C to stop)...")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := w.Run(ctx, func(changed []string) {
+		for _, path := range changed {
+			fmt.Printf("Changed: %s\n", path)
+		}
+
+		configChanged := s.configPath != "" && containsPath(changed, s.configPath)
+		s.regenerate(configChanged)
+
+		if configChanged {
+			w.SetPatterns(s.patterns())
+		}
+	})
+	if err == context.Canceled {
+		return nil
+	}
+	return err
+}
+
+// patterns returns the files and globs to watch.
+func (s *watchSession) patterns() []string {
+	patterns := []string{s.cfg.Schema.Path}
+	patterns = append(patterns, s.cfg.Schema.Includes...)
+	if s.configPath != "" {
+		patterns = append(patterns, s.configPath)
+	}
+	return patterns
+}
+
+// regenerate re-reads the schema (and the config when it changed) and
+// regenerates the affected types. It reports errors instead of returning them.
+func (s *watchSession) regenerate(configChanged bool) {
+	if configChanged {
+		cfg, err := prepareConfig(s.opts)
+		if err != nil {
+			printError(err)
+			return
+		}
+		s.cfg = cfg
+		s.writer = output.NewIncrementalWriter(cfg.Output.Directory)
+		s.fileNames = make(map[string]string)
+		s.aggregates = make(map[string]bool)
+		// Configuration affects every generated file
+		s.schema = nil
+	}
+
+	schema, err := parseSchema(s.cfg)
+	if err != nil {
+		renderErrors(err)
+		return
+	}
+
+	changes := generator.AffectedTypes(s.cfg, s.schema, schema)
+	if changes.IsEmpty() {
+		if s.opts.verbose {
+			fmt.Println("No type changes detected")
+		}
+		s.schema = schema
+		return
+	}
+
+	gen := generator.NewGenerator(s.cfg)
+	// Files derived from the whole schema are regenerated on every change
+	result := gen.GenerateTypes(schema, append(changes.Affected, changes.Removed...))
+	renderErrors(result.Errors...)
+	printWarnings(result.Warnings)
+
+	// Remove files of types that were removed, renamed or are now skipped,
+	// and derived files that are no longer generated
+	generated := make(map[string]string)
+	aggregates := make(map[string]bool)
+	for _, file := range result.Files {
+		if generator.IsTypeFile(schema, file) {
+			generated[file.TypeDef.Name] = file.FileName
+		} else {
+			aggregates[file.FileName] = true
+		}
+	}
+	var stale []string
+	for fileName := range s.aggregates {
+		if !aggregates[fileName] && !containsFile(result.Files, fileName) {
+			stale = append(stale, fileName)
+		}
+	}
+	s.aggregates = aggregates
+	for _, name := range append(changes.Removed, changes.Affected...) {
+		oldFile, ok := s.fileNames[name]
+		if !ok {
+			continue
+		}
+		if newFile, ok := generated[name]; !ok || newFile != oldFile {
+			stale = append(stale, oldFile)
+			delete(s.fileNames, name)
+		}
+	}
+	for name, fileName := range generated {
+		s.fileNames[name] = fileName
+	}
+
+	written := s.writer.Write(result.Files)
+	removed := s.writer.Remove(stale)
+
+	for _, path := range written.Written {
+		fmt.Printf("Generated: %s\n", path)
+	}
+	for _, path := range removed.Removed {
+		fmt.Printf("Removed: %s\n", path)
+	}
+	for _, err := range append(written.Errors, removed.Errors...) {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	}
+
+	fmt.Printf("Regenerated %d type(s): %d written, %d unchanged, %d removed\n",
+		len(generated), len(written.Written), len(written.Skipped), len(removed.Removed))
+
+	// Only remember the schema if it was fully processed, so that failed
+	// types are retried on the next change
+	if len(result.Errors) == 0 {
+		s.schema = schema
+	}
+}
+
+// containsFile returns true if one of the files is named fileName.
+func containsFile(files []*generator.GeneratedFile, fileName string) bool {
+	for _, file := range files {
+		if file.FileName == fileName {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPath(paths []string, target string) bool {
+	targetAbs, _ := filepath.Abs(target)
+	for _, path := range paths {
+		abs, _ := filepath.Abs(path)
+		if abs == targetAbs {
+			return true
+		}
+	}
+	return false
+}
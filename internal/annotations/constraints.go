@@ -0,0 +1,149 @@
+package annotations
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// constraintType is the kind of Java type a constraint applies to.
+type constraintType int
+
+const (
+	constraintTypeOther constraintType = iota
+	constraintTypeString
+	constraintTypeNumber
+	constraintTypeTemporal
+	constraintTypeCollection
+)
+
+// constraintTypeNames maps simple Java type names to their kind.
+var constraintTypeNames = map[string]constraintType{
+	"String": constraintTypeString, "CharSequence": constraintTypeString,
+
+	"byte": constraintTypeNumber, "short": constraintTypeNumber, "int": constraintTypeNumber,
+	"long": constraintTypeNumber, "float": constraintTypeNumber, "double": constraintTypeNumber,
+	"Byte": constraintTypeNumber, "Short": constraintTypeNumber, "Integer": constraintTypeNumber,
+	"Long": constraintTypeNumber, "Float": constraintTypeNumber, "Double": constraintTypeNumber,
+	"BigInteger": constraintTypeNumber, "BigDecimal": constraintTypeNumber, "Number": constraintTypeNumber,
+
+	"Date": constraintTypeTemporal, "Calendar": constraintTypeTemporal, "Instant": constraintTypeTemporal,
+	"LocalDate": constraintTypeTemporal, "LocalDateTime": constraintTypeTemporal, "LocalTime": constraintTypeTemporal,
+	"MonthDay": constraintTypeTemporal, "OffsetDateTime": constraintTypeTemporal, "OffsetTime": constraintTypeTemporal,
+	"Year": constraintTypeTemporal, "YearMonth": constraintTypeTemporal, "ZonedDateTime": constraintTypeTemporal,
+
+	"Collection": constraintTypeCollection, "List": constraintTypeCollection, "Set": constraintTypeCollection,
+	"SortedSet": constraintTypeCollection, "LinkedList": constraintTypeCollection, "Map": constraintTypeCollection,
+}
+
+// classifyConstraintType returns the kind of a Java type. Optional types are
+// classified by their value, since Bean Validation unwraps them.
+func classifyConstraintType(javaType string) constraintType {
+	javaType = strings.TrimSpace(javaType)
+	if strings.HasSuffix(javaType, "[]") {
+		return constraintTypeCollection
+	}
+	if i := strings.IndexByte(javaType, '<'); i >= 0 {
+		if base := javaType[:i]; base == "Optional" || base == "java.util.Optional" {
+			return classifyConstraintType(strings.TrimSuffix(javaType[i+1:], ">"))
+		}
+		javaType = javaType[:i]
+	}
+	if i := strings.LastIndexByte(javaType, '.'); i >= 0 {
+		javaType = javaType[i+1:]
+	}
+	return constraintTypeNames[javaType]
+}
+
+// decimalPattern matches the values of @DecimalMin and @DecimalMax.
+var decimalPattern = regexp.MustCompile(`^[-+]?(\d+(\.\d*)?|\.\d+)([eE][-+]?\d+)?$`)
+
+// typeAnnotationPattern matches type-use annotations in Java types.
+var typeAnnotationPattern = regexp.MustCompile(`@[\w.]+\s+`)
+
+// CheckConstraints reports @constraint arguments that do not apply to the
+// Java type of the field, such as past on a String, and inconsistent
+// arguments. Fields of scalars mapped to Java types gql2j does not know are
+// not checked, since constraint validators may exist for them. Nothing is
+// reported if validation is disabled.
+func (g *ValidationGenerator) CheckConstraints(c *parser.ConstraintDirectiveInfo, javaType string, isScalar bool) []*errors.DirectiveError {
+	if !g.config.Enabled || c == nil {
+		return nil
+	}
+
+	var result []*errors.DirectiveError
+	report := func(message, hint string) {
+		err := errors.NewDirectiveError(message, nil).WithDirective(parser.DirectiveConstraint)
+		err.Hint = hint
+		result = append(result, err)
+	}
+
+	kind := classifyConstraintType(javaType)
+	if kind != constraintTypeOther || !isScalar {
+		for _, check := range []struct {
+			argument string
+			used     bool
+			kinds    []constraintType
+			applies  string
+		}{
+			{"minLength", c.MinLength != nil, []constraintType{constraintTypeString}, "strings; use minItems for lists"},
+			{"maxLength", c.MaxLength != nil, []constraintType{constraintTypeString}, "strings; use maxItems for lists"},
+			{"minItems", c.MinItems != nil, []constraintType{constraintTypeCollection}, "lists; use minLength for strings"},
+			{"maxItems", c.MaxItems != nil, []constraintType{constraintTypeCollection}, "lists; use maxLength for strings"},
+			{"min", c.Min != nil, []constraintType{constraintTypeNumber}, "numbers"},
+			{"max", c.Max != nil, []constraintType{constraintTypeNumber}, "numbers"},
+			{"decimalMin", c.DecimalMin != "", []constraintType{constraintTypeNumber, constraintTypeString}, "numbers and numeric strings"},
+			{"decimalMax", c.DecimalMax != "", []constraintType{constraintTypeNumber, constraintTypeString}, "numbers and numeric strings"},
+			{"positive", c.Positive, []constraintType{constraintTypeNumber}, "numbers"},
+			{"positiveOrZero", c.PositiveOrZero, []constraintType{constraintTypeNumber}, "numbers"},
+			{"negative", c.Negative, []constraintType{constraintTypeNumber}, "numbers"},
+			{"negativeOrZero", c.NegativeOrZero, []constraintType{constraintTypeNumber}, "numbers"},
+			{"integerDigits", c.IntegerDigits != nil, []constraintType{constraintTypeNumber, constraintTypeString}, "numbers and numeric strings"},
+			{"past", c.Past, []constraintType{constraintTypeTemporal}, "date and time types"},
+			{"pastOrPresent", c.PastOrPresent, []constraintType{constraintTypeTemporal}, "date and time types"},
+			{"future", c.Future, []constraintType{constraintTypeTemporal}, "date and time types"},
+			{"futureOrPresent", c.FutureOrPresent, []constraintType{constraintTypeTemporal}, "date and time types"},
+			{"pattern", c.Pattern != "", []constraintType{constraintTypeString}, "strings"},
+			{"notBlank", c.NotBlank, []constraintType{constraintTypeString}, "strings"},
+			{"notEmpty", c.NotEmpty, []constraintType{constraintTypeString, constraintTypeCollection}, "strings and lists"},
+			{"email", c.Email, []constraintType{constraintTypeString}, "strings"},
+		} {
+			if check.used && !containsConstraintType(check.kinds, kind) {
+				report(fmt.Sprintf("@constraint argument %q does not apply to %s", check.argument, typeAnnotationPattern.ReplaceAllString(javaType, "")),
+					"it applies to "+check.applies)
+			}
+		}
+	}
+
+	if (c.MinLength != nil || c.MaxLength != nil) && (c.MinItems != nil || c.MaxItems != nil) {
+		report("@constraint cannot limit both length and items, only minItems and maxItems are used", "")
+	}
+	for _, decimal := range []struct{ argument, value string }{
+		{"decimalMin", c.DecimalMin},
+		{"decimalMax", c.DecimalMax},
+	} {
+		if decimal.value != "" && !decimalPattern.MatchString(decimal.value) {
+			report(fmt.Sprintf("@constraint argument %q must be a decimal number, got %q", decimal.argument, decimal.value), "")
+		}
+	}
+	if c.FractionDigits != nil && c.IntegerDigits == nil {
+		report(`@constraint argument "fractionDigits" requires "integerDigits"`, "")
+	}
+	if len(c.PatternFlags) > 0 && c.Pattern == "" {
+		report(`@constraint argument "patternFlags" requires "pattern"`, "")
+	}
+
+	return result
+}
+
+func containsConstraintType(kinds []constraintType, kind constraintType) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
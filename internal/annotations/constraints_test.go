@@ -0,0 +1,87 @@
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+func constraintMessages(gen *ValidationGenerator, arguments map[string]interface{}, javaType string, isScalar bool) []string {
+	c := parser.ExtractConstraintDirective([]*parser.DirectiveDef{{Name: "constraint", Arguments: arguments}})
+	var messages []string
+	for _, err := range gen.CheckConstraints(c, javaType, isScalar) {
+		messages = append(messages, err.Message)
+	}
+	return messages
+}
+
+func TestClassifyConstraintType(t *testing.T) {
+	tests := map[string]constraintType{
+		"String":                   constraintTypeString,
+		"int":                      constraintTypeNumber,
+		"java.math.BigDecimal":     constraintTypeNumber,
+		"Optional<Integer>":        constraintTypeNumber,
+		"OffsetDateTime":           constraintTypeTemporal,
+		"List<@NotNull String>":    constraintTypeCollection,
+		"java.util.Set<String>":    constraintTypeCollection,
+		"byte[]":                   constraintTypeCollection,
+		"Boolean":                  constraintTypeOther,
+		"com.example.AddressInput": constraintTypeOther,
+	}
+	for javaType, expected := range tests {
+		assert.Equal(t, expected, classifyConstraintType(javaType), javaType)
+	}
+}
+
+func TestValidationGenerator_CheckConstraints(t *testing.T) {
+	gen := NewValidationGenerator(&config.ValidationConfig{Enabled: true})
+
+	assert.Empty(t, constraintMessages(gen, map[string]interface{}{
+		"minLength": int64(1), "pattern": "^a", "notBlank": true, "email": true, "decimalMin": "1.5",
+	}, "String", true))
+	assert.Empty(t, constraintMessages(gen, map[string]interface{}{
+		"min": int64(0), "positive": true, "integerDigits": int64(3), "decimalMax": "-1e3",
+	}, "Integer", true))
+	assert.Empty(t, constraintMessages(gen, map[string]interface{}{"past": true}, "LocalDate", true))
+	assert.Empty(t, constraintMessages(gen, map[string]interface{}{"notEmpty": true, "maxItems": int64(3)}, "List<String>", false))
+
+	assert.Equal(t, []string{
+		`@constraint argument "minLength" does not apply to List<String>`,
+	}, constraintMessages(gen, map[string]interface{}{"minLength": int64(1)}, "List<@NotNull String>", false))
+	assert.Equal(t, []string{
+		`@constraint argument "past" does not apply to Integer`,
+		`@constraint argument "email" does not apply to Integer`,
+	}, constraintMessages(gen, map[string]interface{}{"past": true, "email": true}, "Integer", true))
+	assert.Equal(t, []string{
+		`@constraint argument "positive" does not apply to AddressInput`,
+	}, constraintMessages(gen, map[string]interface{}{"positive": true}, "AddressInput", false))
+}
+
+func TestValidationGenerator_CheckConstraints_UnknownScalarTypes(t *testing.T) {
+	gen := NewValidationGenerator(&config.ValidationConfig{Enabled: true})
+
+	assert.Empty(t, constraintMessages(gen, map[string]interface{}{"positive": true}, "Money", true))
+}
+
+func TestValidationGenerator_CheckConstraints_Inconsistent(t *testing.T) {
+	gen := NewValidationGenerator(&config.ValidationConfig{Enabled: true})
+
+	assert.Equal(t, []string{
+		`@constraint argument "decimalMin" must be a decimal number, got "0,5"`,
+		`@constraint argument "fractionDigits" requires "integerDigits"`,
+		`@constraint argument "patternFlags" requires "pattern"`,
+	}, constraintMessages(gen, map[string]interface{}{
+		"decimalMin":     "0,5",
+		"fractionDigits": int64(2),
+		"patternFlags":   []interface{}{"DOTALL"},
+	}, "BigDecimal", true))
+}
+
+func TestValidationGenerator_CheckConstraints_Disabled(t *testing.T) {
+	gen := NewValidationGenerator(&config.ValidationConfig{Enabled: false})
+
+	assert.Empty(t, constraintMessages(gen, map[string]interface{}{"past": true}, "String", true))
+}
@@ -1,6 +1,13 @@
 package annotations
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
 	"github.com/source-c/go-gql2j/internal/parser"
 )
 
@@ -10,12 +17,26 @@ type CustomAnnotation struct {
 	Imports []string
 }
 
-// CustomAnnotationGenerator generates custom annotations from directives.
-type CustomAnnotationGenerator struct{}
+// CustomAnnotationGenerator generates custom annotations from directives:
+// the @annotation directive and the directives mapped to annotations in the
+// configuration.
+type CustomAnnotationGenerator struct {
+	mappings    map[string][]config.AnnotationConfig
+	definitions map[string]*parser.DirectiveDefinition
+}
 
 // NewCustomAnnotationGenerator creates a new custom annotation generator.
-func NewCustomAnnotationGenerator() *CustomAnnotationGenerator {
-	return &CustomAnnotationGenerator{}
+// mappings maps directive names to annotation templates.
+func NewCustomAnnotationGenerator(mappings map[string][]config.AnnotationConfig) *CustomAnnotationGenerator {
+	return &CustomAnnotationGenerator{
+		mappings: mappings,
+	}
+}
+
+// SetDirectiveDefinitions sets the directive definitions of the schema, whose
+// argument defaults apply to annotation templates.
+func (g *CustomAnnotationGenerator) SetDirectiveDefinitions(definitions map[string]*parser.DirectiveDefinition) {
+	g.definitions = definitions
 }
 
 // GenerateTypeAnnotations extracts custom annotations for a type.
@@ -35,9 +56,6 @@ func (g *CustomAnnotationGenerator) GenerateEnumValueAnnotations(enumValue *pars
 
 func (g *CustomAnnotationGenerator) extractAnnotations(directives []*parser.DirectiveDef) ([]string, []string) {
 	annotationInfos := parser.ExtractAnnotationDirectives(directives)
-	if len(annotationInfos) == 0 {
-		return nil, nil
-	}
 
 	var annotations []string
 	var imports []string
@@ -47,10 +65,140 @@ func (g *CustomAnnotationGenerator) extractAnnotations(directives []*parser.Dire
 		imports = append(imports, info.Imports...)
 	}
 
+	for _, directive := range directives {
+		for _, mapping := range g.mappings[directive.Name] {
+			annotation, missing := g.interpolate(mapping.Value, directive)
+			if len(missing) > 0 {
+				// Reported by CheckDirectiveAnnotations
+				continue
+			}
+			annotation, annotationImports := shortenAnnotation(annotation, mapping.Imports)
+			annotations = append(annotations, annotation)
+			imports = append(imports, annotationImports...)
+		}
+	}
+
 	return annotations, imports
 }
 
-// GenerateDeprecatedAnnotation generates @Deprecated annotation if applicable.
+// placeholderPattern matches the argument placeholders of annotation
+// templates: {name}.
+var placeholderPattern = regexp.MustCompile(`\{([_A-Za-z][_0-9A-Za-z]*)\}`)
+
+// interpolate replaces the placeholders of the directive's arguments in an
+// annotation template with the argument values, or their declared defaults.
+// Braces that do not enclose an argument name are kept, e.g. array values.
+// It returns the names of placeholders that have no value.
+func (g *CustomAnnotationGenerator) interpolate(template string, directive *parser.DirectiveDef) (string, []string) {
+	var missing []string
+	result := placeholderPattern.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, declared := g.argumentValue(directive, name)
+		if !declared {
+			return placeholder
+		}
+		literal, ok := templateValue(value, false)
+		if !ok {
+			missing = append(missing, name)
+			return placeholder
+		}
+		return literal
+	})
+	return result, missing
+}
+
+// argumentValue returns the value of a directive argument, or its declared
+// default. declared is false if the directive has no such argument.
+func (g *CustomAnnotationGenerator) argumentValue(directive *parser.DirectiveDef, name string) (value interface{}, declared bool) {
+	if value, ok := directive.Arguments[name]; ok {
+		return value, true
+	}
+	if definition := g.definitions[directive.Name]; definition != nil {
+		for _, arg := range definition.Arguments {
+			if arg.Name == name {
+				return arg.DefaultValue, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// templateValue formats an argument value for an annotation template.
+// Strings and enum values are inserted as the content of a Java string
+// literal, unless quoted is set, and lists become array initializers with
+// quoted strings: {"a", "b"}. ok is false for null and input object values.
+func templateValue(value interface{}, quoted bool) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		if quoted {
+			return javaString(v), true
+		}
+		s := javaString(v)
+		return s[1 : len(s)-1], true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			literal, ok := templateValue(item, true)
+			if !ok {
+				return "", false
+			}
+			items[i] = literal
+		}
+		return "{" + strings.Join(items, ", ") + "}", true
+	}
+	return "", false
+}
+
+// qualifiedAnnotationPattern matches an annotation with a qualified name:
+// @com.acme.validation.Phone.
+var qualifiedAnnotationPattern = regexp.MustCompile(`^@((?:[_a-z][_0-9A-Za-z]*\.)+)([_A-Z][_0-9A-Za-z]*)`)
+
+// shortenAnnotation writes an annotation with a qualified name by its simple
+// name and imports it.
+func shortenAnnotation(annotation string, imports []string) (string, []string) {
+	match := qualifiedAnnotationPattern.FindStringSubmatch(annotation)
+	if match == nil {
+		return annotation, imports
+	}
+	fqn := match[1] + match[2]
+	annotation = "@" + match[2] + annotation[len(match[0]):]
+	for _, imp := range imports {
+		if imp == fqn {
+			return annotation, imports
+		}
+	}
+	return annotation, append(imports[:len(imports):len(imports)], fqn)
+}
+
+// CheckDirectiveAnnotations reports the placeholders of annotation templates
+// that have no value for a use of a mapped directive, which generation skips.
+func (g *CustomAnnotationGenerator) CheckDirectiveAnnotations(directives []*parser.DirectiveDef) []*errors.DirectiveError {
+	var result []*errors.DirectiveError
+	for _, directive := range directives {
+		for _, mapping := range g.mappings[directive.Name] {
+			_, missing := g.interpolate(mapping.Value, directive)
+			for _, name := range missing {
+				err := errors.NewDirectiveError(
+					fmt.Sprintf("annotation %s of @%s is not generated: argument %q has no value", mapping.Value, directive.Name, name),
+					nil,
+				).WithDirective(directive.Name)
+				err.Hint = fmt.Sprintf("set %s, or declare a default value for it", name)
+				err.Location = directive.Location
+				result = append(result, err)
+			}
+		}
+	}
+	return result
+}
+
+// GenerateDeprecatedAnnotation generates @Deprecated annotation if applicable,
+// and returns it with its import.
 func (g *CustomAnnotationGenerator) GenerateDeprecatedAnnotation(directives []*parser.DirectiveDef) (string, string) {
 	deprecated := parser.ExtractDeprecatedDirective(directives)
 	if deprecated == nil {
@@ -61,5 +209,5 @@ func (g *CustomAnnotationGenerator) GenerateDeprecatedAnnotation(directives []*p
 	// Note: Java 9+ supports @Deprecated(forRemoval = ..., since = "...")
 	// For simplicity, we just use the basic annotation
 
-	return annotation, ""
+	return annotation, "java.lang.Deprecated"
 }
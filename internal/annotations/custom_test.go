@@ -6,16 +6,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/source-c/go-gql2j/internal/config"
 	"github.com/source-c/go-gql2j/internal/parser"
 )
 
 func TestNewCustomAnnotationGenerator(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 	require.NotNil(t, gen)
 }
 
 func TestCustomAnnotationGenerator_GenerateTypeAnnotations_NoDirectives(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	typeDef := &parser.TypeDef{Name: "User"}
 	annotations, imports := gen.GenerateTypeAnnotations(typeDef)
@@ -25,7 +26,7 @@ func TestCustomAnnotationGenerator_GenerateTypeAnnotations_NoDirectives(t *testi
 }
 
 func TestCustomAnnotationGenerator_GenerateTypeAnnotations_WithAnnotation(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	typeDef := &parser.TypeDef{
 		Name: "User",
@@ -46,7 +47,7 @@ func TestCustomAnnotationGenerator_GenerateTypeAnnotations_WithAnnotation(t *tes
 }
 
 func TestCustomAnnotationGenerator_GenerateTypeAnnotations_MultipleAnnotations(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	typeDef := &parser.TypeDef{
 		Name: "User",
@@ -77,7 +78,7 @@ func TestCustomAnnotationGenerator_GenerateTypeAnnotations_MultipleAnnotations(t
 }
 
 func TestCustomAnnotationGenerator_GenerateFieldAnnotations_NoDirectives(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	field := &parser.FieldDef{Name: "name"}
 	annotations, imports := gen.GenerateFieldAnnotations(field)
@@ -87,7 +88,7 @@ func TestCustomAnnotationGenerator_GenerateFieldAnnotations_NoDirectives(t *test
 }
 
 func TestCustomAnnotationGenerator_GenerateFieldAnnotations_WithAnnotation(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	field := &parser.FieldDef{
 		Name: "id",
@@ -108,7 +109,7 @@ func TestCustomAnnotationGenerator_GenerateFieldAnnotations_WithAnnotation(t *te
 }
 
 func TestCustomAnnotationGenerator_GenerateEnumValueAnnotations_NoDirectives(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	enumValue := &parser.EnumValueDef{Name: "ACTIVE"}
 	annotations, imports := gen.GenerateEnumValueAnnotations(enumValue)
@@ -118,7 +119,7 @@ func TestCustomAnnotationGenerator_GenerateEnumValueAnnotations_NoDirectives(t *
 }
 
 func TestCustomAnnotationGenerator_GenerateEnumValueAnnotations_WithAnnotation(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	enumValue := &parser.EnumValueDef{
 		Name: "ACTIVE",
@@ -139,7 +140,7 @@ func TestCustomAnnotationGenerator_GenerateEnumValueAnnotations_WithAnnotation(t
 }
 
 func TestCustomAnnotationGenerator_GenerateDeprecatedAnnotation_NoDirective(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	directives := []*parser.DirectiveDef{}
 	annotation, _ := gen.GenerateDeprecatedAnnotation(directives)
@@ -148,7 +149,7 @@ func TestCustomAnnotationGenerator_GenerateDeprecatedAnnotation_NoDirective(t *t
 }
 
 func TestCustomAnnotationGenerator_GenerateDeprecatedAnnotation_WithDirective(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	directives := []*parser.DirectiveDef{
 		{
@@ -164,7 +165,7 @@ func TestCustomAnnotationGenerator_GenerateDeprecatedAnnotation_WithDirective(t
 }
 
 func TestCustomAnnotationGenerator_GenerateDeprecatedAnnotation_WithoutReason(t *testing.T) {
-	gen := NewCustomAnnotationGenerator()
+	gen := NewCustomAnnotationGenerator(nil)
 
 	directives := []*parser.DirectiveDef{
 		{Name: "deprecated"},
@@ -173,3 +174,58 @@ func TestCustomAnnotationGenerator_GenerateDeprecatedAnnotation_WithoutReason(t
 
 	assert.Equal(t, "@Deprecated", annotation)
 }
+
+func TestCustomAnnotationGenerator_DirectiveAnnotations(t *testing.T) {
+	gen := NewCustomAnnotationGenerator(map[string][]config.AnnotationConfig{
+		"phone": {{Value: `@com.acme.validation.Phone(region = "{region}")`}},
+		"audited": {
+			{Value: "@Audited(by = {by}, level = {level}, tags = {})", Imports: []string{"com.acme.Audited"}},
+			{Value: "@Logged"},
+		},
+	})
+	gen.SetDirectiveDefinitions(map[string]*parser.DirectiveDefinition{
+		"phone": {Name: "phone", Arguments: []*parser.ArgumentDef{{Name: "region", DefaultValue: "US"}}},
+	})
+
+	annotations, imports := gen.GenerateFieldAnnotations(&parser.FieldDef{
+		Name: "mobile",
+		Directives: []*parser.DirectiveDef{
+			{Name: "phone"},
+			{Name: "audited", Arguments: map[string]interface{}{
+				"by":    []interface{}{"ops", `a"b`},
+				"level": int64(2),
+			}},
+		},
+	})
+
+	assert.Equal(t, []string{
+		`@Phone(region = "US")`,
+		`@Audited(by = {"ops", "a\"b"}, level = 2, tags = {})`,
+		"@Logged",
+	}, annotations)
+	assert.Equal(t, []string{"com.acme.validation.Phone", "com.acme.Audited"}, imports)
+
+	annotations, _ = gen.GenerateEnumValueAnnotations(&parser.EnumValueDef{
+		Name:       "ADMIN",
+		Directives: []*parser.DirectiveDef{{Name: "phone", Arguments: map[string]interface{}{"region": `DE"x`}}},
+	})
+	assert.Equal(t, []string{`@Phone(region = "DE\"x")`}, annotations)
+}
+
+func TestCustomAnnotationGenerator_CheckDirectiveAnnotations(t *testing.T) {
+	gen := NewCustomAnnotationGenerator(map[string][]config.AnnotationConfig{
+		"tagged": {{Value: `@Tag("{name}")`}, {Value: "@Tagged"}},
+	})
+	gen.SetDirectiveDefinitions(map[string]*parser.DirectiveDefinition{
+		"tagged": {Name: "tagged", Arguments: []*parser.ArgumentDef{{Name: "name"}}},
+	})
+	directives := []*parser.DirectiveDef{{Name: "tagged"}}
+
+	annotations, _ := gen.GenerateTypeAnnotations(&parser.TypeDef{Name: "User", Directives: directives})
+	assert.Equal(t, []string{"@Tagged"}, annotations)
+
+	errs := gen.CheckDirectiveAnnotations(directives)
+	require.Len(t, errs, 1)
+	assert.Equal(t, `annotation @Tag("{name}") of @tagged is not generated: argument "name" has no value`, errs[0].Message)
+	assert.Equal(t, "set name, or declare a default value for it", errs[0].Hint)
+}
@@ -0,0 +1,73 @@
+package annotations
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+// jsonPropertyImport is the import of the Jackson @JsonProperty annotation.
+const jsonPropertyImport = "com.fasterxml.jackson.annotation.JsonProperty"
+
+// JacksonGenerator generates Jackson annotations.
+type JacksonGenerator struct {
+	config *config.JacksonConfig
+}
+
+// NewJacksonGenerator creates a new Jackson annotation generator.
+func NewJacksonGenerator(cfg *config.JacksonConfig) *JacksonGenerator {
+	return &JacksonGenerator{
+		config: cfg,
+	}
+}
+
+// GeneratePropertyAnnotation generates a @JsonProperty annotation that keeps
+// the schema name of a field or enum value whose Java name differs. Nothing
+// is generated if Jackson is disabled or the other annotations of the element
+// already include a @JsonProperty.
+func (g *JacksonGenerator) GeneratePropertyAnnotation(schemaName, javaName string, others []string) (string, []string) {
+	if !g.config.Enabled || schemaName == javaName {
+		return "", nil
+	}
+	for _, other := range others {
+		if strings.HasPrefix(other, "@JsonProperty") || strings.HasPrefix(other, "@"+jsonPropertyImport) {
+			return "", nil
+		}
+	}
+	return "@JsonProperty(" + strconv.Quote(schemaName) + ")", []string{jsonPropertyImport}
+}
+
+// GenerateIgnoreAnnotation generates @JsonIgnore for a method that is not a
+// property, or nothing if Jackson is disabled.
+func (g *JacksonGenerator) GenerateIgnoreAnnotation() (string, []string) {
+	if !g.config.Enabled {
+		return "", nil
+	}
+	return "@JsonIgnore", []string{"com.fasterxml.jackson.annotation.JsonIgnore"}
+}
+
+// GenerateDeductionAnnotations generates the annotations that let Jackson
+// deserialize a polymorphic type by deducing the subtype from the properties
+// present. Nothing is generated if Jackson is disabled.
+func (g *JacksonGenerator) GenerateDeductionAnnotations(subtypes []string) ([]string, []string) {
+	if !g.config.Enabled || len(subtypes) == 0 {
+		return nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("@JsonSubTypes({\n")
+	for i, subtype := range subtypes {
+		sb.WriteString("    @JsonSubTypes.Type(")
+		sb.WriteString(subtype)
+		sb.WriteString(".class)")
+		if i < len(subtypes)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("})")
+
+	return []string{"@JsonTypeInfo(use = JsonTypeInfo.Id.DEDUCTION)", sb.String()},
+		[]string{"com.fasterxml.jackson.annotation.JsonSubTypes", "com.fasterxml.jackson.annotation.JsonTypeInfo"}
+}
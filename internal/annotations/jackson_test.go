@@ -0,0 +1,31 @@
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+func TestJacksonGenerator_GeneratePropertyAnnotation(t *testing.T) {
+	gen := NewJacksonGenerator(&config.JacksonConfig{Enabled: true})
+
+	annotation, imports := gen.GeneratePropertyAnnotation("userID", "userId", nil)
+	assert.Equal(t, `@JsonProperty("userID")`, annotation)
+	assert.Equal(t, []string{"com.fasterxml.jackson.annotation.JsonProperty"}, imports)
+
+	annotation, _ = gen.GeneratePropertyAnnotation("name", "name", nil)
+	assert.Empty(t, annotation)
+
+	annotation, _ = gen.GeneratePropertyAnnotation("userID", "userId", []string{`@JsonProperty("id")`})
+	assert.Empty(t, annotation)
+}
+
+func TestJacksonGenerator_Disabled(t *testing.T) {
+	gen := NewJacksonGenerator(&config.JacksonConfig{Enabled: false})
+
+	annotation, imports := gen.GeneratePropertyAnnotation("userID", "userId", nil)
+	assert.Empty(t, annotation)
+	assert.Nil(t, imports)
+}
@@ -87,6 +87,18 @@ func (g *LombokGenerator) GenerateTypeAnnotations(typeDef *parser.TypeDef) ([]st
 	// Determine which annotations to include
 	enabled := g.getEnabledAnnotations(lombokDirective)
 
+	// The factory methods of @oneOf classes need the no-args constructor a
+	// generated constructor would suppress, and @Builder then needs its
+	// all-args constructor declared
+	if typeDef.IsOneOf() {
+		if enabled["builder"] {
+			enabled["allArgsConstructor"] = true
+		}
+		if enabled["allArgsConstructor"] || enabled["superBuilder"] {
+			enabled["noArgsConstructor"] = true
+		}
+	}
+
 	for name, include := range enabled {
 		if include {
 			if ann, ok := allAnnotations[name]; ok {
@@ -105,6 +105,31 @@ func TestLombokGenerator_GenerateTypeAnnotations_WithDirectiveOverride(t *testin
 	assert.Contains(t, imports, "lombok.Builder")
 }
 
+func TestLombokGenerator_GenerateTypeAnnotations_OneOf(t *testing.T) {
+	cfg := &config.LombokConfig{
+		Enabled:            true,
+		AllArgsConstructor: true,
+	}
+	gen := NewLombokGenerator(cfg)
+
+	oneOf := &parser.TypeDef{
+		Name:       "PaymentInput",
+		Kind:       parser.TypeKindInputObject,
+		Directives: []*parser.DirectiveDef{{Name: "oneOf"}},
+	}
+	annotations, imports := gen.GenerateTypeAnnotations(oneOf)
+	assert.ElementsMatch(t, []string{"@AllArgsConstructor", "@NoArgsConstructor"}, annotations)
+	assert.Contains(t, imports, "lombok.NoArgsConstructor")
+
+	annotations, _ = gen.GenerateTypeAnnotations(&parser.TypeDef{Name: "CardInput"})
+	assert.Equal(t, []string{"@AllArgsConstructor"}, annotations)
+
+	cfg.AllArgsConstructor = false
+	cfg.Builder = true
+	annotations, _ = gen.GenerateTypeAnnotations(oneOf)
+	assert.ElementsMatch(t, []string{"@Builder", "@AllArgsConstructor", "@NoArgsConstructor"}, annotations)
+}
+
 func TestLombokGenerator_GenerateFieldAnnotations(t *testing.T) {
 	cfg := &config.LombokConfig{Enabled: true}
 	gen := NewLombokGenerator(cfg)
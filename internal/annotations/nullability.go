@@ -0,0 +1,111 @@
+package annotations
+
+import (
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+// nullabilityLibrary describes the nullness annotations of a library.
+type nullabilityLibrary struct {
+	nullable string
+	nonNull  string
+	// typeUse is true if the annotations annotate types, so they can be
+	// placed on type arguments like List<@Nullable String>.
+	typeUse bool
+}
+
+// nullabilityLibraries are the supported nullness annotation libraries.
+var nullabilityLibraries = map[string]nullabilityLibrary{
+	config.NullabilityJSpecify: {
+		nullable: "org.jspecify.annotations.Nullable",
+		nonNull:  "org.jspecify.annotations.NonNull",
+		typeUse:  true,
+	},
+	config.NullabilityJetBrains: {
+		nullable: "org.jetbrains.annotations.Nullable",
+		nonNull:  "org.jetbrains.annotations.NotNull",
+		typeUse:  true,
+	},
+	config.NullabilityJSR305: {
+		nullable: "javax.annotation.Nullable",
+		nonNull:  "javax.annotation.Nonnull",
+	},
+	config.NullabilityChecker: {
+		nullable: "org.checkerframework.checker.nullness.qual.Nullable",
+		nonNull:  "org.checkerframework.checker.nullness.qual.NonNull",
+		typeUse:  true,
+	},
+	config.NullabilityAndroid: {
+		nullable: "androidx.annotation.Nullable",
+		nonNull:  "androidx.annotation.NonNull",
+	},
+}
+
+// NullabilityGenerator generates nullness annotations for the annotation
+// nullable handling mode.
+type NullabilityGenerator struct {
+	config *config.JavaConfig
+}
+
+// NewNullabilityGenerator creates a new nullness annotation generator.
+func NewNullabilityGenerator(cfg *config.JavaConfig) *NullabilityGenerator {
+	return &NullabilityGenerator{
+		config: cfg,
+	}
+}
+
+func (g *NullabilityGenerator) library() (nullabilityLibrary, bool) {
+	if g.config.NullableHandling != config.NullableAnnotation {
+		return nullabilityLibrary{}, false
+	}
+	library := g.config.Nullability.Library
+	if library == "" {
+		library = config.NullabilityJSpecify
+	}
+	lib, ok := nullabilityLibraries[library]
+	return lib, ok
+}
+
+// IsTypeUse returns true if nullness annotations are generated and annotate
+// types rather than declarations.
+func (g *NullabilityGenerator) IsTypeUse() bool {
+	lib, ok := g.library()
+	return ok && lib.typeUse
+}
+
+// GenerateAnnotation generates the nullness annotation of a nullable or
+// non-null type and its import. Non-null types are only annotated if
+// configured, and nothing is generated outside the annotation mode.
+func (g *NullabilityGenerator) GenerateAnnotation(nonNull bool) (string, []string) {
+	lib, ok := g.library()
+	if !ok {
+		return "", nil
+	}
+
+	fqn := lib.nullable
+	if nonNull {
+		if !g.config.Nullability.NonNull {
+			return "", nil
+		}
+		fqn = lib.nonNull
+	}
+	return "@" + fqn[strings.LastIndex(fqn, ".")+1:], []string{fqn}
+}
+
+// AnnotateType places a type-use annotation on a Java type, between the
+// package and the simple name of qualified types: java.util.@Nullable Date.
+func AnnotateType(javaType, annotation string) string {
+	if annotation == "" {
+		return javaType
+	}
+
+	name := javaType
+	if i := strings.IndexByte(name, '<'); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return javaType[:i+1] + annotation + " " + javaType[i+1:]
+	}
+	return annotation + " " + javaType
+}
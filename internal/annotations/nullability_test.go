@@ -0,0 +1,52 @@
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+func TestNullabilityGenerator_GenerateAnnotation(t *testing.T) {
+	cfg := &config.JavaConfig{NullableHandling: config.NullableAnnotation}
+	gen := NewNullabilityGenerator(cfg)
+
+	annotation, imports := gen.GenerateAnnotation(false)
+	assert.Equal(t, "@Nullable", annotation)
+	assert.Equal(t, []string{"org.jspecify.annotations.Nullable"}, imports)
+	assert.True(t, gen.IsTypeUse())
+
+	annotation, _ = gen.GenerateAnnotation(true)
+	assert.Empty(t, annotation)
+
+	cfg.Nullability = config.NullabilityConfig{Library: config.NullabilityJetBrains, NonNull: true}
+	annotation, imports = gen.GenerateAnnotation(true)
+	assert.Equal(t, "@NotNull", annotation)
+	assert.Equal(t, []string{"org.jetbrains.annotations.NotNull"}, imports)
+
+	cfg.Nullability.Library = config.NullabilityAndroid
+	annotation, imports = gen.GenerateAnnotation(false)
+	assert.Equal(t, "@Nullable", annotation)
+	assert.Equal(t, []string{"androidx.annotation.Nullable"}, imports)
+	assert.False(t, gen.IsTypeUse())
+}
+
+func TestNullabilityGenerator_OtherModes(t *testing.T) {
+	for _, mode := range []string{config.NullableWrapper, config.NullableOptional} {
+		gen := NewNullabilityGenerator(&config.JavaConfig{NullableHandling: mode})
+
+		annotation, imports := gen.GenerateAnnotation(false)
+		assert.Empty(t, annotation, mode)
+		assert.Nil(t, imports, mode)
+		assert.False(t, gen.IsTypeUse(), mode)
+	}
+}
+
+func TestAnnotateType(t *testing.T) {
+	assert.Equal(t, "@Nullable String", AnnotateType("String", "@Nullable"))
+	assert.Equal(t, "java.util.@Nullable Date", AnnotateType("java.util.Date", "@Nullable"))
+	assert.Equal(t, "@Nullable List<java.util.Date>", AnnotateType("List<java.util.Date>", "@Nullable"))
+	assert.Equal(t, "java.util.@Nullable List<String>", AnnotateType("java.util.List<String>", "@Nullable"))
+	assert.Equal(t, "String", AnnotateType("String", ""))
+}
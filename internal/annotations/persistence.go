@@ -0,0 +1,138 @@
+package annotations
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// relationAnnotations maps the kinds of @relation to the JPA annotations.
+var relationAnnotations = map[string]string{
+	parser.RelationOneToOne:   "OneToOne",
+	parser.RelationOneToMany:  "OneToMany",
+	parser.RelationManyToOne:  "ManyToOne",
+	parser.RelationManyToMany: "ManyToMany",
+}
+
+// PersistenceGenerator generates the JPA annotations of entities.
+type PersistenceGenerator struct {
+	config     *config.JPAConfig
+	validation *config.ValidationConfig
+}
+
+// NewPersistenceGenerator creates a new JPA annotation generator. The
+// persistence package follows the validation package: jakarta.persistence
+// or javax.persistence.
+func NewPersistenceGenerator(cfg *config.JPAConfig, validation *config.ValidationConfig) *PersistenceGenerator {
+	return &PersistenceGenerator{
+		config:     cfg,
+		validation: validation,
+	}
+}
+
+// GetPersistencePackage returns the persistence package.
+func (g *PersistenceGenerator) GetPersistencePackage() string {
+	if g.validation.Package == config.ValidationJavax {
+		return "javax.persistence"
+	}
+	return "jakarta.persistence"
+}
+
+// IsEntity returns true if the type is generated as an entity: an object
+// type with @entity, while the persistence mode is enabled.
+func (g *PersistenceGenerator) IsEntity(typeDef *parser.TypeDef) bool {
+	return g.config.Enabled && typeDef.Kind == parser.TypeKindObject &&
+		parser.ExtractEntityDirective(typeDef.Directives) != nil
+}
+
+// GenerateTypeAnnotations generates @Entity, and @Table if @entity names the
+// table, for an entity.
+func (g *PersistenceGenerator) GenerateTypeAnnotations(typeDef *parser.TypeDef) ([]string, []string) {
+	if !g.IsEntity(typeDef) {
+		return nil, nil
+	}
+
+	annotations := []string{"@Entity"}
+	imports := []string{g.annotationImport("Entity")}
+	if table := parser.ExtractEntityDirective(typeDef.Directives).Table; table != "" {
+		annotations = append(annotations, "@Table(name = "+strconv.Quote(table)+")")
+		imports = append(imports, g.annotationImport("Table"))
+	}
+	return annotations, imports
+}
+
+// RelationKind returns the relationship kind of a field of an entity, or ""
+// if the field is not a relationship. Fields referencing entities are
+// relationships; unless @relation sets the kind, lists are one-to-many and
+// other fields many-to-one.
+func (g *PersistenceGenerator) RelationKind(field *parser.FieldDef, isEntity func(string) bool) string {
+	if field.Type == nil || !isEntity(field.Type.NamedType()) {
+		return ""
+	}
+	if relation := parser.ExtractRelationDirective(field.Directives); relation != nil && relationAnnotations[relation.Kind] != "" {
+		return relation.Kind
+	}
+	if field.Type.IsList() {
+		return parser.RelationOneToMany
+	}
+	return parser.RelationManyToOne
+}
+
+// GenerateFieldAnnotations generates the annotations of a field of an
+// entity: @Transient for @transient, @Id for @id, the relationship
+// annotation and @JoinColumn for fields referencing entities, and @Column
+// for the other fields with @column. isEntity reports whether a schema type
+// is generated as an entity.
+func (g *PersistenceGenerator) GenerateFieldAnnotations(field *parser.FieldDef, isEntity func(string) bool) ([]string, []string) {
+	if field.HasDirective(parser.DirectiveTransient) {
+		return []string{"@Transient"}, []string{g.annotationImport("Transient")}
+	}
+
+	var annotations, imports []string
+	add := func(annotation, name string) {
+		annotations = append(annotations, annotation)
+		imports = append(imports, g.annotationImport(name))
+	}
+
+	if field.HasDirective(parser.DirectiveID) {
+		add("@Id", "Id")
+	}
+
+	column := parser.ExtractColumnDirective(field.Directives)
+	if kind := g.RelationKind(field, isEntity); kind != "" {
+		name := relationAnnotations[kind]
+		annotation := "@" + name
+		// The owning side of a many-to-one relationship is the field itself
+		if relation := parser.ExtractRelationDirective(field.Directives); relation != nil && relation.MappedBy != "" && kind != parser.RelationManyToOne {
+			annotation += "(mappedBy = " + strconv.Quote(relation.MappedBy) + ")"
+		}
+		add(annotation, name)
+		if column != nil && column.Name != "" {
+			add("@JoinColumn(name = "+strconv.Quote(column.Name)+")", "JoinColumn")
+		}
+		return annotations, imports
+	}
+
+	if column != nil {
+		var params []string
+		if column.Name != "" {
+			params = append(params, "name = "+strconv.Quote(column.Name))
+		}
+		if column.Length > 0 {
+			params = append(params, "length = "+strconv.Itoa(column.Length))
+		}
+		annotation := "@Column"
+		if len(params) > 0 {
+			annotation += "(" + strings.Join(params, ", ") + ")"
+		}
+		add(annotation, "Column")
+	}
+	return annotations, imports
+}
+
+// annotationImport returns the import of a persistence annotation.
+func (g *PersistenceGenerator) annotationImport(name string) string {
+	return g.GetPersistencePackage() + "." + name
+}
@@ -0,0 +1,130 @@
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+func isEntity(name string) bool {
+	return name == "User" || name == "Post"
+}
+
+func TestPersistenceGenerator_GetPersistencePackage(t *testing.T) {
+	jpa := &config.JPAConfig{Enabled: true}
+
+	gen := NewPersistenceGenerator(jpa, &config.ValidationConfig{Package: config.ValidationJakarta})
+	assert.Equal(t, "jakarta.persistence", gen.GetPersistencePackage())
+
+	gen = NewPersistenceGenerator(jpa, &config.ValidationConfig{Package: config.ValidationJavax})
+	assert.Equal(t, "javax.persistence", gen.GetPersistencePackage())
+}
+
+func TestPersistenceGenerator_GenerateTypeAnnotations(t *testing.T) {
+	gen := NewPersistenceGenerator(&config.JPAConfig{Enabled: true}, &config.ValidationConfig{Package: config.ValidationJakarta})
+
+	anns, imports := gen.GenerateTypeAnnotations(&parser.TypeDef{
+		Name:       "User",
+		Kind:       parser.TypeKindObject,
+		Directives: []*parser.DirectiveDef{{Name: parser.DirectiveEntity, Arguments: map[string]interface{}{"table": "users"}}},
+	})
+	assert.Equal(t, []string{"@Entity", `@Table(name = "users")`}, anns)
+	assert.Equal(t, []string{"jakarta.persistence.Entity", "jakarta.persistence.Table"}, imports)
+
+	anns, _ = gen.GenerateTypeAnnotations(&parser.TypeDef{
+		Name:       "UserInput",
+		Kind:       parser.TypeKindInputObject,
+		Directives: []*parser.DirectiveDef{{Name: parser.DirectiveEntity}},
+	})
+	assert.Empty(t, anns)
+}
+
+func TestPersistenceGenerator_Disabled(t *testing.T) {
+	gen := NewPersistenceGenerator(&config.JPAConfig{Enabled: false}, &config.ValidationConfig{})
+
+	anns, imports := gen.GenerateTypeAnnotations(&parser.TypeDef{
+		Name:       "User",
+		Kind:       parser.TypeKindObject,
+		Directives: []*parser.DirectiveDef{{Name: parser.DirectiveEntity}},
+	})
+	assert.Empty(t, anns)
+	assert.Empty(t, imports)
+}
+
+func TestPersistenceGenerator_GenerateFieldAnnotations(t *testing.T) {
+	gen := NewPersistenceGenerator(&config.JPAConfig{Enabled: true}, &config.ValidationConfig{Package: config.ValidationJavax})
+
+	tests := []struct {
+		name     string
+		field    *parser.FieldDef
+		expected []string
+	}{
+		{
+			name: "id",
+			field: &parser.FieldDef{Name: "id", Type: &parser.TypeRef{Name: "ID", NonNull: true},
+				Directives: []*parser.DirectiveDef{{Name: parser.DirectiveID}}},
+			expected: []string{"@Id"},
+		},
+		{
+			name: "column",
+			field: &parser.FieldDef{Name: "email", Type: &parser.TypeRef{Name: "String"},
+				Directives: []*parser.DirectiveDef{{Name: parser.DirectiveColumn, Arguments: map[string]interface{}{"name": "email_address", "length": int64(320)}}}},
+			expected: []string{`@Column(name = "email_address", length = 320)`},
+		},
+		{
+			name: "transient",
+			field: &parser.FieldDef{Name: "score", Type: &parser.TypeRef{Name: "Int"},
+				Directives: []*parser.DirectiveDef{{Name: parser.DirectiveTransient}, {Name: parser.DirectiveColumn}}},
+			expected: []string{"@Transient"},
+		},
+		{
+			name:     "inferred many-to-one",
+			field:    &parser.FieldDef{Name: "author", Type: &parser.TypeRef{Name: "User"}},
+			expected: []string{"@ManyToOne"},
+		},
+		{
+			name:     "inferred one-to-many",
+			field:    &parser.FieldDef{Name: "posts", Type: &parser.TypeRef{Elem: &parser.TypeRef{Name: "Post"}}},
+			expected: []string{"@OneToMany"},
+		},
+		{
+			name: "relation with join column",
+			field: &parser.FieldDef{Name: "author", Type: &parser.TypeRef{Name: "User"},
+				Directives: []*parser.DirectiveDef{
+					{Name: parser.DirectiveRelation, Arguments: map[string]interface{}{"kind": "ONE_TO_ONE"}},
+					{Name: parser.DirectiveColumn, Arguments: map[string]interface{}{"name": "author_id"}},
+				}},
+			expected: []string{"@OneToOne", `@JoinColumn(name = "author_id")`},
+		},
+		{
+			name: "mapped by",
+			field: &parser.FieldDef{Name: "posts", Type: &parser.TypeRef{Elem: &parser.TypeRef{Name: "Post"}},
+				Directives: []*parser.DirectiveDef{{Name: parser.DirectiveRelation, Arguments: map[string]interface{}{"kind": "ONE_TO_MANY", "mappedBy": "author"}}}},
+			expected: []string{`@OneToMany(mappedBy = "author")`},
+		},
+		{
+			name: "no mapped by on many-to-one",
+			field: &parser.FieldDef{Name: "author", Type: &parser.TypeRef{Name: "User"},
+				Directives: []*parser.DirectiveDef{{Name: parser.DirectiveRelation, Arguments: map[string]interface{}{"kind": "MANY_TO_ONE", "mappedBy": "posts"}}}},
+			expected: []string{"@ManyToOne"},
+		},
+		{
+			name:     "no relation to non-entities",
+			field:    &parser.FieldDef{Name: "profile", Type: &parser.TypeRef{Name: "Profile"}},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anns, imports := gen.GenerateFieldAnnotations(tt.field, isEntity)
+			assert.Equal(t, tt.expected, anns)
+			for _, imp := range imports {
+				assert.Contains(t, imp, "javax.persistence.")
+			}
+		})
+	}
+}
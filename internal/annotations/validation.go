@@ -39,6 +39,56 @@ func (g *ValidationGenerator) GetValidationPackage() string {
 	}
 }
 
+// SupportsContainerElements returns true if the targeted Bean Validation
+// version, 2.0 or later, supports constraints on container elements such as
+// List<@NotNull String>.
+func (g *ValidationGenerator) SupportsContainerElements() bool {
+	return g.config.Version != config.ValidationVersion11
+}
+
+// validImport returns the import of the @Valid annotation.
+func (g *ValidationGenerator) validImport() string {
+	return strings.TrimSuffix(g.GetValidationPackage(), ".constraints") + ".Valid"
+}
+
+// GenerateCascadeAnnotation generates @Valid for a field of a generated type,
+// so that the constraints of the nested object are validated. isGenerated
+// reports whether a schema type is generated as a class or interface. Lists
+// are cascaded at the field only before Bean Validation 2.0; later versions
+// annotate the elements instead.
+func (g *ValidationGenerator) GenerateCascadeAnnotation(typeRef *parser.TypeRef, isGenerated func(string) bool) (string, []string) {
+	if !g.config.Enabled || typeRef == nil || !isGenerated(typeRef.NamedType()) {
+		return "", nil
+	}
+	if typeRef.IsList() && g.SupportsContainerElements() {
+		return "", nil
+	}
+	return "@Valid", []string{g.validImport()}
+}
+
+// GenerateElementAnnotations generates the container-element constraints of
+// a list element: @Valid for elements of a generated type and @NotNull for
+// non-null elements. Nothing is generated before Bean Validation 2.0.
+func (g *ValidationGenerator) GenerateElementAnnotations(elem *parser.TypeRef, isGenerated func(string) bool) ([]string, []string) {
+	if !g.config.Enabled || !g.SupportsContainerElements() {
+		return nil, nil
+	}
+
+	var annotations []string
+	var imports []string
+
+	if !elem.IsList() && isGenerated(elem.Name) {
+		annotations = append(annotations, "@Valid")
+		imports = append(imports, g.validImport())
+	}
+	if elem.NonNull && g.config.NotNullOnNonNull {
+		annotations = append(annotations, "@NotNull")
+		imports = append(imports, g.GetValidationPackage()+".NotNull")
+	}
+
+	return annotations, imports
+}
+
 // GenerateFieldAnnotations generates validation annotations for a field.
 func (g *ValidationGenerator) GenerateFieldAnnotations(field *parser.FieldDef, nonNull bool) ([]string, []string) {
 	if !g.config.Enabled {
@@ -69,62 +119,207 @@ func (g *ValidationGenerator) GenerateFieldAnnotations(field *parser.FieldDef, n
 func (g *ValidationGenerator) generateConstraintAnnotations(c *parser.ConstraintDirectiveInfo, basePkg string) ([]string, []string) {
 	var annotations []string
 	var imports []string
+	add := func(name, value string, params ...string) {
+		annotation, annotationImports := g.constraintAnnotation(basePkg, name, value, params, c)
+		annotations = append(annotations, annotation)
+		imports = append(imports, annotationImports...)
+	}
 
-	// @Size for string/collection length constraints
-	if c.MinLength != nil || c.MaxLength != nil {
+	// @Size for string length or list size; list sizes take precedence
+	minSize, maxSize := c.MinLength, c.MaxLength
+	if c.MinItems != nil || c.MaxItems != nil {
+		minSize, maxSize = c.MinItems, c.MaxItems
+	}
+	if minSize != nil || maxSize != nil {
 		var params []string
-		if c.MinLength != nil {
-			params = append(params, fmt.Sprintf("min = %d", *c.MinLength))
+		if minSize != nil {
+			params = append(params, fmt.Sprintf("min = %d", *minSize))
 		}
-		if c.MaxLength != nil {
-			params = append(params, fmt.Sprintf("max = %d", *c.MaxLength))
+		if maxSize != nil {
+			params = append(params, fmt.Sprintf("max = %d", *maxSize))
 		}
-		annotations = append(annotations, fmt.Sprintf("@Size(%s)", strings.Join(params, ", ")))
-		imports = append(imports, basePkg+".Size")
+		add("Size", "", params...)
 	}
 
 	// @Min and @Max for numeric constraints
 	if c.Min != nil {
-		annotations = append(annotations, fmt.Sprintf("@Min(%d)", *c.Min))
-		imports = append(imports, basePkg+".Min")
+		add("Min", fmt.Sprint(*c.Min))
 	}
 	if c.Max != nil {
-		annotations = append(annotations, fmt.Sprintf("@Max(%d)", *c.Max))
-		imports = append(imports, basePkg+".Max")
+		add("Max", fmt.Sprint(*c.Max))
+	}
+	if c.DecimalMin != "" {
+		add("DecimalMin", javaString(c.DecimalMin))
+	}
+	if c.DecimalMax != "" {
+		add("DecimalMax", javaString(c.DecimalMax))
+	}
+
+	// Sign constraints
+	for _, sign := range []struct {
+		set  bool
+		name string
+	}{
+		{c.Positive, "Positive"},
+		{c.PositiveOrZero, "PositiveOrZero"},
+		{c.Negative, "Negative"},
+		{c.NegativeOrZero, "NegativeOrZero"},
+	} {
+		if sign.set {
+			add(sign.name, "")
+		}
+	}
+
+	// @Digits, fraction digits default to 0
+	if c.IntegerDigits != nil {
+		fraction := 0
+		if c.FractionDigits != nil {
+			fraction = *c.FractionDigits
+		}
+		add("Digits", "", fmt.Sprintf("integer = %d", *c.IntegerDigits), fmt.Sprintf("fraction = %d", fraction))
+	}
+
+	// Temporal constraints
+	for _, temporal := range []struct {
+		set  bool
+		name string
+	}{
+		{c.Past, "Past"},
+		{c.PastOrPresent, "PastOrPresent"},
+		{c.Future, "Future"},
+		{c.FutureOrPresent, "FutureOrPresent"},
+	} {
+		if temporal.set {
+			add(temporal.name, "")
+		}
 	}
 
 	// @Pattern for regex constraints
 	if c.Pattern != "" {
-		// Escape quotes in pattern
-		escapedPattern := strings.ReplaceAll(c.Pattern, "\"", "\\\"")
-		annotations = append(annotations, fmt.Sprintf("@Pattern(regexp = \"%s\")", escapedPattern))
-		imports = append(imports, basePkg+".Pattern")
+		params := []string{"regexp = " + javaString(c.Pattern)}
+		if flags := patternFlags(c.PatternFlags); flags != "" {
+			params = append(params, "flags = "+flags)
+		}
+		add("Pattern", "", params...)
 	}
 
 	// @NotNull from constraint
 	if c.NotNull {
-		annotations = append(annotations, "@NotNull")
-		imports = append(imports, basePkg+".NotNull")
+		add("NotNull", "")
 	}
 
 	// @NotBlank for non-blank strings
 	if c.NotBlank {
-		annotations = append(annotations, "@NotBlank")
-		imports = append(imports, basePkg+".NotBlank")
+		add("NotBlank", "")
+	}
+
+	// @NotEmpty for non-empty strings and lists
+	if c.NotEmpty {
+		add("NotEmpty", "")
 	}
 
 	// @Email for email validation
 	if c.Email {
-		annotations = append(annotations, "@Email")
-		imports = append(imports, basePkg+".Email")
+		add("Email", "")
 	}
 
 	return annotations, imports
 }
 
+// constraintAnnotation formats a constraint annotation of the validation
+// package with the message and groups of the directive. The value parameter
+// is written without a name if it is the only parameter.
+func (g *ValidationGenerator) constraintAnnotation(basePkg, name, value string, params []string, c *parser.ConstraintDirectiveInfo) (string, []string) {
+	imports := []string{basePkg + "." + name}
+
+	if c.Message != "" {
+		params = append(params, "message = "+javaString(c.Message))
+	}
+	if len(c.Groups) > 0 {
+		groups := make([]string, len(c.Groups))
+		for i, group := range c.Groups {
+			if group == "Default" {
+				group = strings.TrimSuffix(basePkg, ".constraints") + ".groups.Default"
+			}
+			if dot := strings.LastIndex(group, "."); dot >= 0 {
+				imports = append(imports, group)
+				group = group[dot+1:]
+			}
+			groups[i] = group + ".class"
+		}
+		params = append(params, "groups = "+arrayValue(groups))
+	}
+
+	if value != "" {
+		if len(params) == 0 {
+			return fmt.Sprintf("@%s(%s)", name, value), imports
+		}
+		params = append([]string{"value = " + value}, params...)
+	}
+	if len(params) == 0 {
+		return "@" + name, imports
+	}
+	return fmt.Sprintf("@%s(%s)", name, strings.Join(params, ", ")), imports
+}
+
+// patternFlags formats the flags parameter of @Pattern, or returns "".
+func patternFlags(flags []string) string {
+	if len(flags) == 0 {
+		return ""
+	}
+	values := make([]string, len(flags))
+	for i, flag := range flags {
+		values[i] = "Pattern.Flag." + flag
+	}
+	return arrayValue(values)
+}
+
+// arrayValue formats an annotation array parameter value, omitting the
+// braces of single elements.
+func arrayValue(values []string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return "{" + strings.Join(values, ", ") + "}"
+}
+
+// javaString returns s as a Java string literal.
+func javaString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// GenerateOneOfAnnotation generates the @AssertTrue annotation of the method
+// that checks that exactly one field of a @oneOf input object is set.
+func (g *ValidationGenerator) GenerateOneOfAnnotation(typeName string) (string, []string) {
+	if !g.config.Enabled {
+		return "", nil
+	}
+	message := fmt.Sprintf("exactly one field of %s must be set", typeName)
+	return "@AssertTrue(message = " + javaString(message) + ")", []string{g.GetValidationPackage() + ".AssertTrue"}
+}
+
 // GenerateTypeAnnotations generates validation annotations for a type (if any).
 func (g *ValidationGenerator) GenerateTypeAnnotations(typeDef *parser.TypeDef) ([]string, []string) {
-	// Type-level validation annotations could include @Valid for nested objects
-	// Currently not implemented but structure is here for extension
+	// Nested objects are validated through @Valid on the fields referencing
+	// them, see GenerateCascadeAnnotation
 	return nil, nil
 }
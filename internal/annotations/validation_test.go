@@ -283,3 +283,120 @@ func TestValidationGenerator_GenerateTypeAnnotations(t *testing.T) {
 	// Currently no type-level validation annotations
 	assert.Empty(t, annotations)
 }
+
+func TestValidationGenerator_GenerateCascadeAnnotation(t *testing.T) {
+	isGenerated := func(name string) bool { return name == "Address" }
+	cfg := &config.ValidationConfig{Enabled: true, Package: config.ValidationJakarta}
+	gen := NewValidationGenerator(cfg)
+
+	annotation, imports := gen.GenerateCascadeAnnotation(&parser.TypeRef{Name: "Address", NonNull: true}, isGenerated)
+	assert.Equal(t, "@Valid", annotation)
+	assert.Equal(t, []string{"jakarta.validation.Valid"}, imports)
+
+	annotation, _ = gen.GenerateCascadeAnnotation(&parser.TypeRef{Name: "String"}, isGenerated)
+	assert.Empty(t, annotation)
+
+	// Lists cascade through their elements, except before Bean Validation 2.0
+	list := &parser.TypeRef{Elem: &parser.TypeRef{Name: "Address"}}
+	annotation, _ = gen.GenerateCascadeAnnotation(list, isGenerated)
+	assert.Empty(t, annotation)
+
+	cfg.Package = config.ValidationJavax
+	cfg.Version = config.ValidationVersion11
+	annotation, imports = gen.GenerateCascadeAnnotation(list, isGenerated)
+	assert.Equal(t, "@Valid", annotation)
+	assert.Equal(t, []string{"javax.validation.Valid"}, imports)
+}
+
+func TestValidationGenerator_GenerateElementAnnotations(t *testing.T) {
+	isGenerated := func(name string) bool { return name == "Address" }
+	cfg := &config.ValidationConfig{Enabled: true, Package: config.ValidationJakarta, NotNullOnNonNull: true}
+	gen := NewValidationGenerator(cfg)
+
+	annotations, imports := gen.GenerateElementAnnotations(&parser.TypeRef{Name: "Address", NonNull: true}, isGenerated)
+	assert.Equal(t, []string{"@Valid", "@NotNull"}, annotations)
+	assert.Equal(t, []string{"jakarta.validation.Valid", "jakarta.validation.constraints.NotNull"}, imports)
+
+	annotations, _ = gen.GenerateElementAnnotations(&parser.TypeRef{Name: "String"}, isGenerated)
+	assert.Empty(t, annotations)
+
+	annotations, _ = gen.GenerateElementAnnotations(&parser.TypeRef{Elem: &parser.TypeRef{Name: "Address"}}, isGenerated)
+	assert.Empty(t, annotations)
+
+	cfg.Version = config.ValidationVersion11
+	annotations, imports = gen.GenerateElementAnnotations(&parser.TypeRef{Name: "Address", NonNull: true}, isGenerated)
+	assert.Nil(t, annotations)
+	assert.Nil(t, imports)
+}
+
+func TestValidationGenerator_GenerateFieldAnnotations_ExtendedConstraints(t *testing.T) {
+	gen := NewValidationGenerator(&config.ValidationConfig{Enabled: true, Package: config.ValidationJakarta})
+
+	tests := []struct {
+		arguments map[string]interface{}
+		expected  []string
+	}{
+		{
+			map[string]interface{}{"decimalMin": "0.01", "decimalMax": "999.99"},
+			[]string{`@DecimalMin("0.01")`, `@DecimalMax("999.99")`},
+		},
+		{
+			map[string]interface{}{"positive": true, "negativeOrZero": true},
+			[]string{"@Positive", "@NegativeOrZero"},
+		},
+		{
+			map[string]interface{}{"integerDigits": int64(6), "fractionDigits": int64(2)},
+			[]string{"@Digits(integer = 6, fraction = 2)"},
+		},
+		{
+			map[string]interface{}{"integerDigits": int64(3)},
+			[]string{"@Digits(integer = 3, fraction = 0)"},
+		},
+		{
+			map[string]interface{}{"past": true, "futureOrPresent": true},
+			[]string{"@Past", "@FutureOrPresent"},
+		},
+		{
+			map[string]interface{}{"notEmpty": true, "minItems": int64(1), "maxItems": int64(5)},
+			[]string{"@Size(min = 1, max = 5)", "@NotEmpty"},
+		},
+		{
+			map[string]interface{}{"pattern": `^\d+$`, "patternFlags": []interface{}{"CASE_INSENSITIVE", "MULTILINE"}},
+			[]string{`@Pattern(regexp = "^\\d+$", flags = {Pattern.Flag.CASE_INSENSITIVE, Pattern.Flag.MULTILINE})`},
+		},
+		{
+			map[string]interface{}{"min": int64(1), "message": `must be "positive"`},
+			[]string{`@Min(value = 1, message = "must be \"positive\"")`},
+		},
+	}
+
+	for _, tt := range tests {
+		field := &parser.FieldDef{
+			Name:       "value",
+			Directives: []*parser.DirectiveDef{{Name: "constraint", Arguments: tt.arguments}},
+		}
+		annotations, _ := gen.GenerateFieldAnnotations(field, false)
+		assert.Equal(t, tt.expected, annotations)
+	}
+}
+
+func TestValidationGenerator_GenerateFieldAnnotations_Groups(t *testing.T) {
+	gen := NewValidationGenerator(&config.ValidationConfig{Enabled: true, Package: config.ValidationJavax})
+
+	field := &parser.FieldDef{
+		Name: "name",
+		Directives: []*parser.DirectiveDef{{Name: "constraint", Arguments: map[string]interface{}{
+			"notBlank": true,
+			"email":    true,
+			"groups":   []interface{}{"com.example.OnCreate", "Default"},
+		}}},
+	}
+	annotations, imports := gen.GenerateFieldAnnotations(field, false)
+
+	assert.Equal(t, []string{
+		"@NotBlank(groups = {OnCreate.class, Default.class})",
+		"@Email(groups = {OnCreate.class, Default.class})",
+	}, annotations)
+	assert.Contains(t, imports, "com.example.OnCreate")
+	assert.Contains(t, imports, "javax.validation.groups.Default")
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
 
 	"gopkg.in/yaml.v3"
 
@@ -43,6 +46,16 @@ func Parse(data []byte) (*Config, error) {
 func (c *Config) Validate() error {
 	errs := errors.NewErrorCollection()
 
+	// Validate target language
+	if c.Target != "" && !isValidTarget(c.Target) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid target: %s (valid: java, kotlin)", c.Target),
+			nil,
+		).WithField("target"))
+	} else if c.Target == TargetKotlin {
+		c.validateKotlin(errs)
+	}
+
 	// Validate Java version
 	if !isValidJavaVersion(c.Java.Version) {
 		errs.Add(errors.NewConfigError(
@@ -75,6 +88,57 @@ func (c *Config) Validate() error {
 		).WithField("java.nullableHandling"))
 	}
 
+	// Validate nullability library
+	if c.Java.Nullability.Library != "" && !isValidNullabilityLibrary(c.Java.Nullability.Library) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid nullability library: %s (valid: jspecify, jetbrains, jsr305, checker, android)", c.Java.Nullability.Library),
+			nil,
+		).WithField("java.nullability.library"))
+	}
+
+	// Validate naming collision strategy
+	if c.Java.Naming.Collisions != "" && !isValidCollisions(c.Java.Naming.Collisions) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid naming collision strategy: %s (valid: error, suffix)", c.Java.Naming.Collisions),
+			nil,
+		).WithField("java.naming.collisions"))
+	}
+
+	// Validate acronym and enum value case
+	if c.Java.Naming.AcronymCase != "" && !isValidAcronymCase(c.Java.Naming.AcronymCase) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid acronym case: %s (valid: preserve, camel, upper)", c.Java.Naming.AcronymCase),
+			nil,
+		).WithField("java.naming.acronymCase"))
+	}
+	for i, acronym := range c.Java.Naming.Acronyms {
+		if !isValidAcronym(acronym) {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid acronym: %q (must be letters and digits starting with an upper case letter)", acronym),
+				nil,
+			).WithField(fmt.Sprintf("java.naming.acronyms[%d]", i)))
+		}
+	}
+	if c.Java.Naming.EnumValueCase != "" && !isValidEnumValueCase(c.Java.Naming.EnumValueCase) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid enum value case: %s (valid: keep, UPPER_SNAKE, PascalCase)", c.Java.Naming.EnumValueCase),
+			nil,
+		).WithField("java.naming.enumValueCase"))
+	}
+
+	// Validate @oneOf style
+	if c.Java.OneOf != "" && !isValidOneOf(c.Java.OneOf) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid oneOf style: %s (valid: class, sealed)", c.Java.OneOf),
+			nil,
+		).WithField("java.oneOf"))
+	} else if c.Java.OneOf == OneOfSealed && c.Java.Version < 17 {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("oneOf style sealed requires Java 17 or later, got %d", c.Java.Version),
+			nil,
+		).WithField("java.oneOf"))
+	}
+
 	// Validate validation package
 	if c.Features.Validation.Enabled && !isValidValidationPackage(c.Features.Validation.Package) {
 		errs.Add(errors.NewConfigError(
@@ -82,6 +146,106 @@ func (c *Config) Validate() error {
 			nil,
 		).WithField("features.validation.package"))
 	}
+	if c.Features.Validation.Enabled && c.Features.Validation.Version != "" && !isValidValidationVersion(c.Features.Validation.Version) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid Bean Validation version: %s (valid: 1.1, 2.0, 3.0)", c.Features.Validation.Version),
+			nil,
+		).WithField("features.validation.version"))
+	}
+
+	// Validate name constants
+	if c.Features.Constants.Enabled {
+		if style := c.Features.Constants.Style; style != "" && style != ConstantsNested && style != ConstantsSeparate {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid constants style: %s (valid: nested, separate)", style),
+				nil,
+			).WithField("features.constants.style"))
+		}
+		if name := c.Features.Constants.ClassName; name != "" && !isValidJavaIdentifier(name) {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid constants class name: %q", name),
+				nil,
+			).WithField("features.constants.className"))
+		}
+	}
+
+	// Validate runtime wiring
+	if c.Features.RuntimeWiring.Enabled {
+		if name := c.Features.RuntimeWiring.ClassName; name != "" && !isValidJavaIdentifier(name) {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid runtime wiring class name: %q", name),
+				nil,
+			).WithField("features.runtimeWiring.className"))
+		}
+		scalars := make([]string, 0, len(c.TypeMappings.Scalars))
+		for scalar := range c.TypeMappings.Scalars {
+			scalars = append(scalars, scalar)
+		}
+		sort.Strings(scalars)
+		for _, scalar := range scalars {
+			coercing := c.TypeMappings.Scalars[scalar].Coercing
+			field := "typeMappings.scalars." + scalar + ".coercing"
+			switch {
+			case coercing == "" && !isBuiltinScalar(scalar):
+				errs.Add(errors.NewConfigError(
+					fmt.Sprintf("scalar %s has no coercing, required by the runtime wiring", scalar),
+					nil,
+				).WithField(field))
+			case coercing != "" && !isValidJavaPackage(coercing):
+				errs.Add(errors.NewConfigError(
+					fmt.Sprintf("invalid coercing class: %q", coercing),
+					nil,
+				).WithField(field))
+			}
+		}
+	}
+
+	// Validate lint rules and severities
+	rules := make([]string, 0, len(c.Lint.Rules))
+	for rule := range c.Lint.Rules {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	for _, rule := range rules {
+		severity := c.Lint.Rules[rule]
+		if _, ok := DefaultLintRules()[rule]; !ok {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("unknown lint rule: %s", rule),
+				nil,
+			).WithField("lint.rules." + rule))
+			continue
+		}
+		if !isValidSeverity(severity) {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid severity for lint rule %s: %s (valid: off, info, warning, error)", rule, severity),
+				nil,
+			).WithField("lint.rules." + rule))
+		}
+	}
+
+	// Validate directive annotation mappings
+	directives := make([]string, 0, len(c.DirectiveAnnotations))
+	for directive := range c.DirectiveAnnotations {
+		directives = append(directives, directive)
+	}
+	sort.Strings(directives)
+	for _, directive := range directives {
+		if !isValidGraphQLName(directive) {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid directive name: %q", directive),
+				nil,
+			).WithField("directiveAnnotations." + directive))
+			continue
+		}
+		for i, annotation := range c.DirectiveAnnotations[directive] {
+			if !strings.HasPrefix(annotation.Value, "@") {
+				errs.Add(errors.NewConfigError(
+					fmt.Sprintf("invalid annotation for directive @%s: %q (must start with @)", directive, annotation.Value),
+					nil,
+				).WithField(fmt.Sprintf("directiveAnnotations.%s[%d].value", directive, i)))
+			}
+		}
+	}
 
 	// Validate output package format
 	if c.Output.Package != "" && !isValidJavaPackage(c.Output.Package) {
@@ -91,9 +255,115 @@ func (c *Config) Validate() error {
 		).WithField("output.package"))
 	}
 
+	// Validate package-info and module-info settings
+	if nn := c.Output.PackageInfo.NonNullByDefault; nn != "" && nn != NonNullByDefaultJSpecify && nn != NonNullByDefaultJSR305 {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid non-null default: %s (valid: jspecify, jsr305)", nn),
+			nil,
+		).WithField("output.packageInfo.nonNullByDefault"))
+	}
+	for i, annotation := range c.Output.PackageInfo.Annotations {
+		if !strings.HasPrefix(annotation.Value, "@") {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid package annotation: %q (must start with @)", annotation.Value),
+				nil,
+			).WithField(fmt.Sprintf("output.packageInfo.annotations[%d].value", i)))
+		}
+	}
+	if c.Output.ModuleInfo.Enabled {
+		c.validateModuleInfo(errs)
+	}
+
 	return errs.ToError()
 }
 
+// validateKotlin rejects the features that only apply to Java code.
+func (c *Config) validateKotlin(errs *errors.ErrorCollection) {
+	features := []struct {
+		field   string
+		enabled bool
+	}{
+		{"features.lombok.enabled", c.Features.Lombok.Enabled},
+		{"features.constants.enabled", c.Features.Constants.Enabled},
+		{"features.projections.enabled", c.Features.Projections.Enabled},
+		{"features.runtimeWiring.enabled", c.Features.RuntimeWiring.Enabled},
+		{"features.controllers.enabled", c.Features.Controllers.Enabled},
+		{"features.jpa.enabled", c.Features.JPA.Enabled},
+		{"features.federation.entitiesResolver", c.Features.Federation.EntitiesResolver},
+		{"output.packageInfo.enabled", c.Output.PackageInfo.Enabled},
+		{"output.moduleInfo.enabled", c.Output.ModuleInfo.Enabled},
+	}
+	for _, feature := range features {
+		if feature.enabled {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("%s is not supported with target kotlin", feature.field),
+				nil,
+			).WithField(feature.field))
+		}
+	}
+}
+
+// validateModuleInfo validates the module-info.java settings.
+func (c *Config) validateModuleInfo(errs *errors.ErrorCollection) {
+	if c.Java.Version < 11 {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("module-info.java requires Java 11 or later, got %d", c.Java.Version),
+			nil,
+		).WithField("output.moduleInfo.enabled"))
+	}
+	if name := c.ModuleName(); name != "" && !isValidJavaPackage(name) {
+		errs.Add(errors.NewConfigError(
+			fmt.Sprintf("invalid module name: %s", name),
+			nil,
+		).WithField("output.moduleInfo.name"))
+	}
+	for i, module := range c.Output.ModuleInfo.Requires {
+		name := strings.TrimPrefix(strings.TrimPrefix(module, "static "), "transitive ")
+		if !isValidJavaPackage(name) {
+			errs.Add(errors.NewConfigError(
+				fmt.Sprintf("invalid required module: %s", module),
+				nil,
+			).WithField(fmt.Sprintf("output.moduleInfo.requires[%d]", i)))
+		}
+	}
+	if _, ok := c.ModuleSourceRoot(); !ok {
+		err := errors.NewConfigError(
+			fmt.Sprintf("cannot place module-info.java: output directory %s does not end in the package path", c.Output.Directory),
+			nil,
+		).WithField("output.moduleInfo.directory")
+		err.WithHint("set output.moduleInfo.directory to the source root, or generate to <source root>/" +
+			strings.ReplaceAll(c.Output.Package, ".", "/"))
+		errs.Add(err)
+	}
+}
+
+// ModuleName returns the name of the generated module: the configured name
+// or the output package.
+func (c *Config) ModuleName() string {
+	if c.Output.ModuleInfo.Name != "" {
+		return c.Output.ModuleInfo.Name
+	}
+	return c.Output.Package
+}
+
+// ModuleSourceRoot returns the directory module-info.java is written to: the
+// configured directory, or the output directory without the package path.
+func (c *Config) ModuleSourceRoot() (string, bool) {
+	if dir := c.Output.ModuleInfo.Directory; dir != "" {
+		return dir, true
+	}
+
+	dir := filepath.Clean(c.Output.Directory)
+	segments := strings.Split(c.Output.Package, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if filepath.Base(dir) != segments[i] {
+			return "", false
+		}
+		dir = filepath.Dir(dir)
+	}
+	return dir, true
+}
+
 // applyVersionOverrides applies Java version-specific overrides.
 func (c *Config) applyVersionOverrides() {
 	if overrides, ok := c.JavaVersionOverrides[c.Java.Version]; ok {
@@ -110,6 +380,10 @@ func (c *Config) Merge(other *Config) {
 		return
 	}
 
+	if other.Target != "" {
+		c.Target = other.Target
+	}
+
 	// Schema
 	if other.Schema.Path != "" {
 		c.Schema.Path = other.Schema.Path
@@ -139,6 +413,12 @@ func (c *Config) Merge(other *Config) {
 	if other.Java.NullableHandling != "" {
 		c.Java.NullableHandling = other.Java.NullableHandling
 	}
+	if other.Java.Nullability.Library != "" {
+		c.Java.Nullability.Library = other.Java.Nullability.Library
+	}
+	if other.Java.OneOf != "" {
+		c.Java.OneOf = other.Java.OneOf
+	}
 
 	// Type mappings
 	for k, v := range other.TypeMappings.Scalars {
@@ -147,6 +427,14 @@ func (c *Config) Merge(other *Config) {
 		}
 		c.TypeMappings.Scalars[k] = v
 	}
+
+	// Directive annotations
+	for k, v := range other.DirectiveAnnotations {
+		if c.DirectiveAnnotations == nil {
+			c.DirectiveAnnotations = make(map[string][]AnnotationConfig)
+		}
+		c.DirectiveAnnotations[k] = v
+	}
 }
 
 // ResolvePaths resolves relative paths in the configuration.
@@ -165,6 +453,10 @@ func (c *Config) ResolvePaths(basePath string) error {
 		c.Output.Directory = filepath.Join(basePath, c.Output.Directory)
 	}
 
+	if dir := c.Output.ModuleInfo.Directory; dir != "" && !filepath.IsAbs(dir) {
+		c.Output.ModuleInfo.Directory = filepath.Join(basePath, dir)
+	}
+
 	return nil
 }
 
@@ -195,6 +487,54 @@ func isValidCollectionType(ct string) bool {
 	return false
 }
 
+func isValidCollisions(strategy string) bool {
+	return strategy == CollisionsError || strategy == CollisionsSuffix
+}
+
+func isValidAcronymCase(ac string) bool {
+	switch ac {
+	case AcronymCasePreserve, AcronymCaseCamel, AcronymCaseUpper:
+		return true
+	}
+	return false
+}
+
+func isValidAcronym(acronym string) bool {
+	for i, r := range acronym {
+		if i == 0 && !unicode.IsUpper(r) {
+			return false
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return acronym != ""
+}
+
+func isValidEnumValueCase(evc string) bool {
+	switch evc {
+	case EnumValueCaseKeep, EnumValueCaseUpperSnake, EnumValueCasePascal:
+		return true
+	}
+	return false
+}
+
+func isBuiltinScalar(name string) bool {
+	switch name {
+	case "Int", "Float", "String", "Boolean", "ID":
+		return true
+	}
+	return false
+}
+
+func isValidTarget(target string) bool {
+	return target == TargetJava || target == TargetKotlin
+}
+
+func isValidOneOf(style string) bool {
+	return style == OneOfClass || style == OneOfSealed
+}
+
 func isValidNullableHandling(nh string) bool {
 	switch nh {
 	case NullableWrapper, NullableOptional, NullableAnnotation:
@@ -203,6 +543,14 @@ func isValidNullableHandling(nh string) bool {
 	return false
 }
 
+func isValidNullabilityLibrary(library string) bool {
+	switch library {
+	case NullabilityJSpecify, NullabilityJetBrains, NullabilityJSR305, NullabilityChecker, NullabilityAndroid:
+		return true
+	}
+	return false
+}
+
 func isValidValidationPackage(pkg string) bool {
 	switch pkg {
 	case ValidationJakarta, ValidationJavax:
@@ -211,6 +559,33 @@ func isValidValidationPackage(pkg string) bool {
 	return false
 }
 
+func isValidValidationVersion(version string) bool {
+	switch version {
+	case ValidationVersion11, ValidationVersion20, ValidationVersion30:
+		return true
+	}
+	return false
+}
+
+func isValidSeverity(s string) bool {
+	switch s {
+	case SeverityOff, SeverityInfo, SeverityWarning, SeverityError:
+		return true
+	}
+	return false
+}
+
+// isValidGraphQLName returns true if name is a GraphQL name: a letter or
+// underscore followed by letters, digits and underscores.
+func isValidGraphQLName(name string) bool {
+	for i, r := range name {
+		if r > unicode.MaxASCII || !(r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+	return name != ""
+}
+
 func isValidJavaPackage(pkg string) bool {
 	if pkg == "" {
 		return false
@@ -230,6 +605,15 @@ func isValidJavaPackage(pkg string) bool {
 	return true
 }
 
+func isValidJavaIdentifier(name string) bool {
+	for i, r := range name {
+		if i == 0 && !isJavaIdentifierStart(r) || !isJavaIdentifierPart(r) {
+			return false
+		}
+	}
+	return name != ""
+}
+
 func isJavaIdentifierStart(r rune) bool {
 	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_' || r == '$'
 }
@@ -23,6 +23,9 @@ func TestDefaultConfig(t *testing.T) {
 	assert.False(t, cfg.Features.Lombok.Enabled)
 	assert.False(t, cfg.Features.Validation.Enabled)
 	assert.Equal(t, ValidationJakarta, cfg.Features.Validation.Package)
+	assert.False(t, cfg.Features.Federation.SkipInaccessible)
+	assert.False(t, cfg.Features.Federation.EntityReferences)
+	assert.False(t, cfg.Features.Federation.EntitiesResolver)
 }
 
 func TestLoad_ValidConfig(t *testing.T) {
@@ -126,6 +129,19 @@ func TestConfig_Validate_InvalidNullableHandling(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid nullable handling")
 }
 
+func TestConfig_Validate_InvalidCollisions(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, CollisionsError, cfg.Java.Naming.Collisions)
+
+	cfg.Java.Naming.Collisions = "rename"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid naming collision strategy")
+
+	cfg.Java.Naming.Collisions = CollisionsSuffix
+	assert.NoError(t, cfg.Validate())
+}
+
 func TestConfig_Validate_InvalidValidationPackage(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Features.Validation.Enabled = true
@@ -145,6 +161,32 @@ func TestConfig_Validate_InvalidPackageName(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid Java package name")
 }
 
+func TestConfig_Validate_LintRules(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Lint.Rules["no-such-rule"] = SeverityError
+	cfg.Lint.Rules[LintUnusedType] = "fatal"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown lint rule: no-such-rule")
+	assert.Contains(t, err.Error(), "invalid severity for lint rule unused-type: fatal")
+}
+
+func TestParse_LintRulesKeepDefaults(t *testing.T) {
+	content := `
+lint:
+  rules:
+    missing-description: off
+    naming-convention: error
+`
+	cfg, err := Parse([]byte(content))
+	require.NoError(t, err)
+
+	assert.Equal(t, SeverityOff, cfg.Lint.Rules[LintMissingDescription])
+	assert.Equal(t, SeverityError, cfg.Lint.Rules[LintNamingConvention])
+	assert.Equal(t, SeverityWarning, cfg.Lint.Rules[LintUnusedType])
+}
+
 func TestConfig_Validate_MultipleErrors(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Java.Version = 5
@@ -247,3 +289,185 @@ features:
 	// Java 8 should use javax instead of jakarta
 	assert.Equal(t, ValidationJavax, cfg.Features.Validation.Package)
 }
+
+func TestConfig_Validate_NamingCases(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, AcronymCasePreserve, cfg.Java.Naming.AcronymCase)
+	assert.Equal(t, EnumValueCaseKeep, cfg.Java.Naming.EnumValueCase)
+
+	cfg.Java.Naming.AcronymCase = "lower"
+	cfg.Java.Naming.Acronyms = []string{"OAuth", "gRPC", ""}
+	cfg.Java.Naming.EnumValueCase = "SCREAMING_SNAKE"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid acronym case: lower")
+	assert.Contains(t, err.Error(), `invalid acronym: "gRPC"`)
+	assert.Contains(t, err.Error(), `invalid acronym: ""`)
+	assert.Contains(t, err.Error(), "invalid enum value case: SCREAMING_SNAKE")
+
+	cfg.Java.Naming.AcronymCase = AcronymCaseCamel
+	cfg.Java.Naming.Acronyms = []string{"OAuth", "GRPC"}
+	cfg.Java.Naming.EnumValueCase = EnumValueCasePascal
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_PackageInfo(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Output.PackageInfo.NonNullByDefault = "checker"
+	cfg.Output.PackageInfo.Annotations = []AnnotationConfig{{Value: "NullMarked"}}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid non-null default: checker")
+	assert.Contains(t, err.Error(), `invalid package annotation: "NullMarked"`)
+
+	cfg.Output.PackageInfo.NonNullByDefault = NonNullByDefaultJSR305
+	cfg.Output.PackageInfo.Annotations = []AnnotationConfig{{Value: "@NullMarked"}}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_ModuleInfo(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Java.Version = 8
+	cfg.Output.Directory = "generated"
+	cfg.Output.ModuleInfo = ModuleInfoConfig{Enabled: true, Name: "com.example-api", Requires: []string{"static lombok", "java sql"}}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "module-info.java requires Java 11 or later, got 8")
+	assert.Contains(t, err.Error(), "invalid module name: com.example-api")
+	assert.Contains(t, err.Error(), "invalid required module: java sql")
+	assert.NotContains(t, err.Error(), "invalid required module: static lombok")
+	assert.Contains(t, err.Error(), "output directory generated does not end in the package path")
+
+	cfg.Java.Version = 17
+	cfg.Output.Directory = filepath.Join("src", "main", "java", "com", "example", "model")
+	cfg.Output.ModuleInfo = ModuleInfoConfig{Enabled: true, Requires: []string{"static lombok"}}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, "com.example.model", cfg.ModuleName())
+
+	root, ok := cfg.ModuleSourceRoot()
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join("src", "main", "java"), root)
+}
+
+func TestConfig_Validate_Nullability(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, NullabilityJSpecify, cfg.Java.Nullability.Library)
+
+	cfg.Java.Nullability.Library = "lombok"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid nullability library: lombok")
+
+	cfg.Java.Nullability.Library = NullabilityChecker
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_ValidationVersion(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Features.Validation.Enabled = true
+	cfg.Features.Validation.Version = "2"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid Bean Validation version: 2")
+
+	cfg.Features.Validation.Version = ValidationVersion11
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_OneOf(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, OneOfClass, cfg.Java.OneOf)
+
+	cfg.Java.OneOf = "union"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid oneOf style: union")
+
+	cfg.Java.OneOf = OneOfSealed
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Java.Version = 11
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "oneOf style sealed requires Java 17 or later, got 11")
+}
+
+func TestConfig_Validate_DirectiveAnnotations(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.DirectiveAnnotations = map[string][]AnnotationConfig{
+		"phone":    {{Value: `@Phone(region = "{region}")`, Imports: []string{"com.acme.Phone"}}},
+		"audited":  {{Value: "Audited"}},
+		"not-name": {{Value: "@Named"}},
+	}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid annotation for directive @audited: "Audited" (must start with @)`)
+	assert.Contains(t, err.Error(), `invalid directive name: "not-name"`)
+	assert.NotContains(t, err.Error(), "@phone")
+
+	delete(cfg.DirectiveAnnotations, "audited")
+	delete(cfg.DirectiveAnnotations, "not-name")
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_Constants(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, ConstantsNested, cfg.Features.Constants.Style)
+	assert.Equal(t, "GraphQLConstants", cfg.Features.Constants.ClassName)
+
+	cfg.Features.Constants = ConstantsConfig{Enabled: true, Style: "inline", ClassName: "Dgs-Constants"}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid constants style: inline")
+	assert.Contains(t, err.Error(), `invalid constants class name: "Dgs-Constants"`)
+
+	cfg.Features.Constants = ConstantsConfig{Enabled: true, Style: ConstantsSeparate, ClassName: "DgsConstants"}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_Target(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, TargetJava, cfg.Target)
+
+	cfg.Target = "scala"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid target: scala")
+
+	cfg.Target = TargetKotlin
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Features.Lombok.Enabled = true
+	cfg.Output.PackageInfo.Enabled = true
+	cfg.Features.Controllers.Enabled = true
+	cfg.Features.JPA.Enabled = true
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "features.lombok.enabled is not supported with target kotlin")
+	assert.Contains(t, err.Error(), "features.controllers.enabled is not supported with target kotlin")
+	assert.Contains(t, err.Error(), "features.jpa.enabled is not supported with target kotlin")
+	assert.Contains(t, err.Error(), "output.packageInfo.enabled is not supported with target kotlin")
+}
+
+func TestConfig_Validate_RuntimeWiring(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, "GeneratedRuntimeWiring", cfg.Features.RuntimeWiring.ClassName)
+
+	cfg.Features.RuntimeWiring = RuntimeWiringConfig{Enabled: true, ClassName: "Runtime-Wiring"}
+	cfg.TypeMappings.Scalars = map[string]ScalarMapping{
+		"DateTime": {JavaType: "OffsetDateTime"},
+		"JSON":     {JavaType: "Object", Coercing: "com.example.Json Coercing"},
+		"ID":       {JavaType: "Long"},
+	}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid runtime wiring class name: "Runtime-Wiring"`)
+	assert.Contains(t, err.Error(), "scalar DateTime has no coercing, required by the runtime wiring")
+	assert.Contains(t, err.Error(), `invalid coercing class: "com.example.Json Coercing"`)
+	assert.NotContains(t, err.Error(), "scalar ID")
+
+	cfg.Features.RuntimeWiring.ClassName = "RuntimeWiringConfigurer"
+	cfg.TypeMappings.Scalars["DateTime"] = ScalarMapping{JavaType: "OffsetDateTime", Coercing: "DateTimeCoercing"}
+	cfg.TypeMappings.Scalars["JSON"] = ScalarMapping{JavaType: "Object", Coercing: "com.example.JsonCoercing"}
+	assert.NoError(t, cfg.Validate())
+}
@@ -7,6 +7,7 @@ func DefaultConfig() *Config {
 			Path:     "",
 			Includes: []string{},
 		},
+		Target: TargetJava,
 		Output: OutputConfig{
 			Directory: "./generated",
 			Package:   "com.example.model",
@@ -16,33 +17,66 @@ func DefaultConfig() *Config {
 			FieldVisibility:  VisibilityPrivate,
 			CollectionType:   CollectionList,
 			NullableHandling: NullableWrapper,
+			Nullability: NullabilityConfig{
+				Library: NullabilityJSpecify,
+			},
 			Naming: NamingConfig{
 				FieldCase:       FieldCaseCamel,
 				ClassSuffix:     "",
 				InterfacePrefix: "",
+				Collisions:      CollisionsError,
+				AcronymCase:     AcronymCasePreserve,
+				EnumValueCase:   EnumValueCaseKeep,
 			},
+			OneOf: OneOfClass,
 		},
 		TypeMappings: TypeMappingsConfig{
 			Scalars: map[string]ScalarMapping{},
 		},
 		Features: FeaturesConfig{
 			Lombok: LombokConfig{
-				Enabled:           false,
-				Data:              true,
-				Builder:           false,
-				NoArgsConstructor: true,
+				Enabled:            false,
+				Data:               true,
+				Builder:            false,
+				NoArgsConstructor:  true,
 				AllArgsConstructor: false,
-				Getter:            false,
-				Setter:            false,
+				Getter:             false,
+				Setter:             false,
 			},
 			Validation: ValidationConfig{
-				Enabled:        false,
-				Package:        ValidationJakarta,
+				Enabled:          false,
+				Package:          ValidationJakarta,
 				NotNullOnNonNull: true,
 			},
 			Jackson: JacksonConfig{
 				Enabled: false,
 			},
+			Federation: FederationConfig{
+				SkipInaccessible: false,
+				EntityReferences: false,
+				EntitiesResolver: false,
+			},
+			Constants: ConstantsConfig{
+				Enabled:   false,
+				Style:     ConstantsNested,
+				ClassName: "GraphQLConstants",
+			},
+			Projections: ProjectionsConfig{
+				Enabled: false,
+			},
+			RuntimeWiring: RuntimeWiringConfig{
+				Enabled:   false,
+				ClassName: "GeneratedRuntimeWiring",
+			},
+			Controllers: ControllersConfig{
+				Enabled: false,
+			},
+			JPA: JPAConfig{
+				Enabled: false,
+			},
+		},
+		Lint: LintConfig{
+			Rules: DefaultLintRules(),
 		},
 		JavaVersionOverrides: map[int]JavaVersionOverrides{
 			8: {
@@ -94,6 +128,19 @@ func DefaultScalarMappings() map[string]ScalarMapping {
 	}
 }
 
+// DefaultLintRules returns the default severity of every lint rule.
+func DefaultLintRules() map[string]string {
+	return map[string]string{
+		LintNamingConvention:      SeverityWarning,
+		LintMissingDescription:    SeverityInfo,
+		LintUnusedType:            SeverityWarning,
+		LintNullableListElement:   SeverityInfo,
+		LintInconsistentID:        SeverityWarning,
+		LintDirectiveArgumentType: SeverityError,
+		LintJavaNameIdentifier:    SeverityError,
+	}
+}
+
 // SupportedJavaVersions returns the list of supported Java versions.
 func SupportedJavaVersions() []int {
 	return []int{8, 11, 17, 21}
@@ -2,11 +2,17 @@ package config
 
 // Config represents the complete configuration for the generator.
 type Config struct {
+	// Target is the language of the generated code: java or kotlin.
+	Target               string                       `yaml:"target"`
 	Schema               SchemaConfig                 `yaml:"schema"`
 	Output               OutputConfig                 `yaml:"output"`
 	Java                 JavaConfig                   `yaml:"java"`
 	TypeMappings         TypeMappingsConfig           `yaml:"typeMappings"`
 	Features             FeaturesConfig               `yaml:"features"`
+	Lint                 LintConfig                   `yaml:"lint"`
+	// DirectiveAnnotations maps directive names to the annotations generated
+	// for types, fields and enum values that use the directive.
+	DirectiveAnnotations map[string][]AnnotationConfig `yaml:"directiveAnnotations"`
 	JavaVersionOverrides map[int]JavaVersionOverrides `yaml:"javaVersionOverrides"`
 }
 
@@ -18,8 +24,43 @@ type SchemaConfig struct {
 
 // OutputConfig contains output-related configuration.
 type OutputConfig struct {
+	Directory   string            `yaml:"directory"`
+	Package     string            `yaml:"package"`
+	PackageInfo PackageInfoConfig `yaml:"packageInfo"`
+	ModuleInfo  ModuleInfoConfig  `yaml:"moduleInfo"`
+}
+
+// PackageInfoConfig contains package-info.java settings.
+type PackageInfoConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Description is the package Javadoc.
+	Description string `yaml:"description"`
+	// NonNullByDefault adds the package annotation of a nullness library
+	// that makes unannotated types non-null: jspecify or jsr305.
+	NonNullByDefault string `yaml:"nonNullByDefault"`
+	// Annotations are added to the package declaration.
+	Annotations []AnnotationConfig `yaml:"annotations"`
+}
+
+// AnnotationConfig is an annotation with the imports it needs. In
+// directiveAnnotations, the value is a template in which {name} is replaced
+// with the value of the directive argument name.
+type AnnotationConfig struct {
+	Value   string   `yaml:"value"`
+	Imports []string `yaml:"imports"`
+}
+
+// ModuleInfoConfig contains module-info.java settings.
+type ModuleInfoConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Name is the module name, the output package by default.
+	Name string `yaml:"name"`
+	// Directory is the source root module-info.java is written to. By
+	// default it is derived from an output directory ending in the package
+	// path, e.g. src/main/java for src/main/java/com/example/model.
 	Directory string `yaml:"directory"`
-	Package   string `yaml:"package"`
+	// Requires are required in addition to the modules of enabled features.
+	Requires []string `yaml:"requires"`
 }
 
 // JavaConfig contains Java generation settings.
@@ -28,7 +69,22 @@ type JavaConfig struct {
 	FieldVisibility  string       `yaml:"fieldVisibility"`
 	CollectionType   string       `yaml:"collectionType"`
 	NullableHandling string       `yaml:"nullableHandling"`
+	Nullability      NullabilityConfig `yaml:"nullability"`
 	Naming           NamingConfig `yaml:"naming"`
+	// OneOf selects how @oneOf input objects are generated: class, a class
+	// with one factory method per field, or sealed, a sealed interface with
+	// one record per field (Java 17+).
+	OneOf string `yaml:"oneOf"`
+}
+
+// NullabilityConfig selects the nullness annotations of the annotation
+// nullable handling mode.
+type NullabilityConfig struct {
+	// Library is the annotation library: jspecify, jetbrains, jsr305,
+	// checker or android.
+	Library string `yaml:"library"`
+	// NonNull annotates non-null fields too, not only nullable ones.
+	NonNull bool `yaml:"nonNull"`
 }
 
 // NamingConfig contains naming convention settings.
@@ -36,6 +92,18 @@ type NamingConfig struct {
 	FieldCase       string `yaml:"fieldCase"`
 	ClassSuffix     string `yaml:"classSuffix"`
 	InterfacePrefix string `yaml:"interfacePrefix"`
+	// Collisions selects what happens when distinct schema elements map to
+	// the same Java name: error or suffix.
+	Collisions string `yaml:"collisions"`
+	// AcronymCase selects how acronyms in field names are written: preserve
+	// keeps them as in the schema, camel writes them like words (userId) and
+	// upper writes known acronyms in upper case (userID).
+	AcronymCase string `yaml:"acronymCase"`
+	// Acronyms are recognized in addition to the built-in ones, e.g. OAuth.
+	Acronyms []string `yaml:"acronyms"`
+	// EnumValueCase selects the enum constant names: keep, UPPER_SNAKE or
+	// PascalCase.
+	EnumValueCase string `yaml:"enumValueCase"`
 }
 
 // TypeMappingsConfig contains type mapping configuration.
@@ -47,13 +115,23 @@ type TypeMappingsConfig struct {
 type ScalarMapping struct {
 	JavaType string   `yaml:"javaType"`
 	Imports  []string `yaml:"imports"`
+	// Coercing is the qualified name of the graphql-java Coercing
+	// implementation of the scalar, registered by the generated runtime
+	// wiring.
+	Coercing string `yaml:"coercing"`
 }
 
 // FeaturesConfig contains feature toggle configuration.
 type FeaturesConfig struct {
-	Lombok     LombokConfig     `yaml:"lombok"`
-	Validation ValidationConfig `yaml:"validation"`
-	Jackson    JacksonConfig    `yaml:"jackson"`
+	Lombok        LombokConfig        `yaml:"lombok"`
+	Validation    ValidationConfig    `yaml:"validation"`
+	Jackson       JacksonConfig       `yaml:"jackson"`
+	Federation    FederationConfig    `yaml:"federation"`
+	Constants     ConstantsConfig     `yaml:"constants"`
+	Projections   ProjectionsConfig   `yaml:"projections"`
+	RuntimeWiring RuntimeWiringConfig `yaml:"runtimeWiring"`
+	Controllers   ControllersConfig   `yaml:"controllers"`
+	JPA           JPAConfig           `yaml:"jpa"`
 }
 
 // LombokConfig contains Lombok-related settings.
@@ -72,6 +150,9 @@ type ValidationConfig struct {
 	Enabled        bool   `yaml:"enabled"`
 	Package        string `yaml:"package"`
 	NotNullOnNonNull bool `yaml:"notNullOnNonNull"`
+	// Version is the targeted Bean Validation version: 1.1, 2.0 or 3.0.
+	// Container-element constraints require 2.0, the default.
+	Version string `yaml:"version"`
 }
 
 // JacksonConfig contains Jackson serialization settings.
@@ -79,11 +160,69 @@ type JacksonConfig struct {
 	Enabled bool `yaml:"enabled"`
 }
 
+// FederationConfig contains Apollo Federation settings.
+type FederationConfig struct {
+	SkipInaccessible bool `yaml:"skipInaccessible"`
+	EntityReferences bool `yaml:"entityReferences"`
+	EntitiesResolver bool `yaml:"entitiesResolver"`
+}
+
+// ConstantsConfig contains settings of the constants of GraphQL type, field
+// and argument names.
+type ConstantsConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Style places the field name constants of a type in a nested Fields
+	// class (nested) or in a separate <Type>Fields class (separate).
+	Style string `yaml:"style"`
+	// ClassName is the name of the class with the constants of the root
+	// types, their fields and arguments.
+	ClassName string `yaml:"className"`
+}
+
+// ProjectionsConfig contains settings of the projection classes, which
+// build GraphQL queries selecting fields of a type.
+type ProjectionsConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// RuntimeWiringConfig contains settings of the generated class wiring the
+// scalars, type resolvers and enum values of the schema into a graphql-java
+// RuntimeWiring.
+type RuntimeWiringConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// ClassName is the name of the generated class.
+	ClassName string `yaml:"className"`
+}
+
+// ControllersConfig contains settings of the abstract Spring for GraphQL
+// controllers generated for the root types and the fields with arguments.
+type ControllersConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// JPAConfig contains settings of the persistence mode, which generates JPA
+// entities for the object types with @entity.
+type JPAConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// LintConfig contains schema lint settings.
+type LintConfig struct {
+	// Rules maps a rule name to its severity (off, info, warning, error).
+	Rules map[string]string `yaml:"rules"`
+}
+
 // JavaVersionOverrides contains overrides for specific Java versions.
 type JavaVersionOverrides struct {
 	Features FeaturesConfig `yaml:"features"`
 }
 
+// Target language constants.
+const (
+	TargetJava   = "java"
+	TargetKotlin = "kotlin"
+)
+
 // FieldVisibility constants.
 const (
 	VisibilityPrivate   = "private"
@@ -106,14 +245,87 @@ const (
 	NullableAnnotation = "annotation"
 )
 
+// Nullability library constants.
+const (
+	NullabilityJSpecify  = "jspecify"
+	NullabilityJetBrains = "jetbrains"
+	NullabilityJSR305    = "jsr305"
+	NullabilityChecker   = "checker"
+	NullabilityAndroid   = "android"
+)
+
+// NonNullByDefault constants.
+const (
+	NonNullByDefaultJSpecify = "jspecify"
+	NonNullByDefaultJSR305   = "jsr305"
+)
+
+// Constants style constants.
+const (
+	ConstantsNested   = "nested"
+	ConstantsSeparate = "separate"
+)
+
+// OneOf style constants.
+const (
+	OneOfClass  = "class"
+	OneOfSealed = "sealed"
+)
+
 // FieldCase constants.
 const (
 	FieldCaseCamel = "camelCase"
 	FieldCaseSnake = "snake_case"
 )
 
+// Naming collision strategies.
+const (
+	CollisionsError  = "error"
+	CollisionsSuffix = "suffix"
+)
+
+// AcronymCase constants.
+const (
+	AcronymCasePreserve = "preserve"
+	AcronymCaseCamel    = "camel"
+	AcronymCaseUpper    = "upper"
+)
+
+// EnumValueCase constants.
+const (
+	EnumValueCaseKeep       = "keep"
+	EnumValueCaseUpperSnake = "UPPER_SNAKE"
+	EnumValueCasePascal     = "PascalCase"
+)
+
 // ValidationPackage constants.
 const (
 	ValidationJakarta = "jakarta"
 	ValidationJavax   = "javax"
 )
+
+// Bean Validation version constants.
+const (
+	ValidationVersion11 = "1.1"
+	ValidationVersion20 = "2.0"
+	ValidationVersion30 = "3.0"
+)
+
+// Lint severity constants.
+const (
+	SeverityOff     = "off"
+	SeverityInfo    = "info"
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+)
+
+// Lint rule names.
+const (
+	LintNamingConvention      = "naming-convention"
+	LintMissingDescription    = "missing-description"
+	LintUnusedType            = "unused-type"
+	LintNullableListElement   = "nullable-list-element"
+	LintInconsistentID        = "inconsistent-id"
+	LintDirectiveArgumentType = "directive-argument-type"
+	LintJavaNameIdentifier    = "java-name-identifier"
+)
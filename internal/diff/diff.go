@@ -0,0 +1,514 @@
+// Package diff compares two versions of a GraphQL schema and classifies the
+// changes by their impact on clients and on the generated Java code.
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/generator"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// Severity classifies the impact of a change.
+type Severity string
+
+// Severity constants, from most to least severe.
+const (
+	SeverityBreaking  Severity = "BREAKING"
+	SeverityDangerous Severity = "DANGEROUS"
+	SeveritySafe      Severity = "SAFE"
+)
+
+// rank orders severities for sorting.
+func (s Severity) rank() int {
+	switch s {
+	case SeverityBreaking:
+		return 0
+	case SeverityDangerous:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Change kinds.
+const (
+	TypeRemoved             = "TYPE_REMOVED"
+	TypeAdded               = "TYPE_ADDED"
+	TypeKindChanged         = "TYPE_KIND_CHANGED"
+	FieldRemoved            = "FIELD_REMOVED"
+	FieldAdded              = "FIELD_ADDED"
+	RequiredInputFieldAdded = "REQUIRED_INPUT_FIELD_ADDED"
+	FieldTypeChanged        = "FIELD_TYPE_CHANGED"
+	NullabilityTightened    = "NULLABILITY_TIGHTENED"
+	NullabilityLoosened     = "NULLABILITY_LOOSENED"
+	DefaultValueChanged     = "DEFAULT_VALUE_CHANGED"
+	ArgumentRemoved         = "ARGUMENT_REMOVED"
+	ArgumentAdded           = "ARGUMENT_ADDED"
+	RequiredArgumentAdded   = "REQUIRED_ARGUMENT_ADDED"
+	EnumValueRemoved        = "ENUM_VALUE_REMOVED"
+	EnumValueAdded          = "ENUM_VALUE_ADDED"
+	UnionMemberRemoved      = "UNION_MEMBER_REMOVED"
+	UnionMemberAdded        = "UNION_MEMBER_ADDED"
+	InterfaceRemoved        = "INTERFACE_REMOVED"
+	InterfaceAdded          = "INTERFACE_ADDED"
+	JavaTypeRenamed         = "JAVA_TYPE_RENAMED"
+	JavaTypeRemoved         = "JAVA_TYPE_REMOVED"
+	JavaFieldRenamed        = "JAVA_FIELD_RENAMED"
+	JavaFieldRemoved        = "JAVA_FIELD_REMOVED"
+	JavaFieldTypeChanged    = "JAVA_FIELD_TYPE_CHANGED"
+	JavaEnumValueRenamed    = "JAVA_ENUM_VALUE_RENAMED"
+	ScalarMappingChanged    = "SCALAR_MAPPING_CHANGED"
+)
+
+// Change is a single difference between two schema versions.
+type Change struct {
+	Severity Severity         `json:"severity"`
+	Kind     string           `json:"kind"`
+	Path     string           `json:"path"`
+	Message  string           `json:"message"`
+	Location *errors.Location `json:"location,omitempty"`
+}
+
+// Summary counts the changes per severity.
+type Summary struct {
+	Breaking  int `json:"breaking"`
+	Dangerous int `json:"dangerous"`
+	Safe      int `json:"safe"`
+}
+
+// Report is the result of comparing two schemas.
+type Report struct {
+	Changes []*Change `json:"changes"`
+	Summary Summary   `json:"summary"`
+}
+
+// HasBreaking returns true if the report contains breaking changes.
+func (r *Report) HasBreaking() bool {
+	return r.Summary.Breaking > 0
+}
+
+// comparison holds the generation contexts of both schema versions.
+type comparison struct {
+	old     *generator.Context
+	new     *generator.Context
+	changes []*Change
+}
+
+// Compare returns the changes from the old schema to the new one. Each
+// configuration determines the Java names and type mappings of its side, so
+// changed scalar mappings and naming settings are detected as well.
+func Compare(oldSchema, newSchema *parser.Schema, oldCfg, newCfg *config.Config) *Report {
+	c := &comparison{
+		old: generator.NewContext(oldCfg, oldSchema),
+		new: generator.NewContext(newCfg, newSchema),
+	}
+
+	for _, name := range typeNames(oldSchema, newSchema) {
+		oldType := oldSchema.GetType(name)
+		newType := newSchema.GetType(name)
+
+		switch {
+		case newType == nil:
+			c.add(SeverityBreaking, TypeRemoved, name, oldType.Location,
+				"type %s was removed", name)
+		case oldType == nil:
+			c.add(SeveritySafe, TypeAdded, name, newType.Location,
+				"type %s was added", name)
+		case oldType.Kind != newType.Kind:
+			c.add(SeverityBreaking, TypeKindChanged, name, newType.Location,
+				"type %s changed from %s to %s", name, oldType.Kind, newType.Kind)
+		default:
+			c.compareType(oldType, newType)
+		}
+	}
+
+	sort.SliceStable(c.changes, func(i, j int) bool {
+		a, b := c.changes[i], c.changes[j]
+		if a.Severity != b.Severity {
+			return a.Severity.rank() < b.Severity.rank()
+		}
+		return a.Path < b.Path
+	})
+
+	report := &Report{Changes: c.changes}
+	if report.Changes == nil {
+		report.Changes = []*Change{}
+	}
+	for _, change := range c.changes {
+		switch change.Severity {
+		case SeverityBreaking:
+			report.Summary.Breaking++
+		case SeverityDangerous:
+			report.Summary.Dangerous++
+		default:
+			report.Summary.Safe++
+		}
+	}
+	return report
+}
+
+func (c *comparison) add(severity Severity, kind, path string, loc *errors.Location, format string, args ...interface{}) {
+	c.changes = append(c.changes, &Change{
+		Severity: severity,
+		Kind:     kind,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+		Location: loc,
+	})
+}
+
+func (c *comparison) compareType(oldType, newType *parser.TypeDef) {
+	name := newType.Name
+	oldTC := generator.NewTypeContext(c.old, oldType)
+	newTC := generator.NewTypeContext(c.new, newType)
+
+	if newType.Kind == parser.TypeKindScalar {
+		c.compareScalar(oldType, newType)
+		return
+	}
+
+	if !oldTC.ShouldSkip() && newTC.ShouldSkip() {
+		c.add(SeverityBreaking, JavaTypeRemoved, name, newType.Location,
+			"Java type %s is no longer generated", oldTC.TypeName)
+		return
+	}
+	if oldTC.ShouldSkip() || newTC.ShouldSkip() {
+		return
+	}
+
+	if oldTC.TypeName != newTC.TypeName {
+		c.add(SeverityBreaking, JavaTypeRenamed, name, newType.Location,
+			"Java type of %s was renamed from %s to %s", name, oldTC.TypeName, newTC.TypeName)
+	}
+
+	for _, iface := range missing(oldType.Interfaces, newType.Interfaces) {
+		c.add(SeverityBreaking, InterfaceRemoved, name, newType.Location,
+			"type %s no longer implements %s", name, iface)
+	}
+	for _, iface := range missing(newType.Interfaces, oldType.Interfaces) {
+		c.add(SeveritySafe, InterfaceAdded, name, newType.Location,
+			"type %s now implements %s", name, iface)
+	}
+
+	for _, member := range missing(oldType.PossibleTypes, newType.PossibleTypes) {
+		c.add(SeverityBreaking, UnionMemberRemoved, name, newType.Location,
+			"%s was removed from union %s", member, name)
+	}
+	for _, member := range missing(newType.PossibleTypes, oldType.PossibleTypes) {
+		c.add(SeverityDangerous, UnionMemberAdded, name, newType.Location,
+			"%s was added to union %s; code handling every member must cover it", member, name)
+	}
+
+	c.compareEnumValues(oldTC, newTC)
+	c.compareFields(oldTC, newTC)
+}
+
+func (c *comparison) compareScalar(oldType, newType *parser.TypeDef) {
+	oldJava := c.scalarJavaType(c.old, oldType.Name)
+	newJava := c.scalarJavaType(c.new, newType.Name)
+	if oldJava != newJava {
+		c.add(SeverityBreaking, ScalarMappingChanged, newType.Name, newType.Location,
+			"scalar %s now maps to %s instead of %s", newType.Name, newJava, oldJava)
+	}
+}
+
+func (c *comparison) scalarJavaType(ctx *generator.Context, name string) string {
+	result, err := ctx.TypeMapper.MapType(&parser.TypeRef{Name: name, NonNull: true})
+	if err != nil {
+		return name
+	}
+	return result.JavaType
+}
+
+func (c *comparison) compareEnumValues(oldTC, newTC *generator.TypeContext) {
+	name := newTC.TypeDef.Name
+
+	newValues := make(map[string]*parser.EnumValueDef)
+	for _, ev := range newTC.TypeDef.EnumValues {
+		newValues[ev.Name] = ev
+	}
+	oldValues := make(map[string]*parser.EnumValueDef)
+	for _, ev := range oldTC.TypeDef.EnumValues {
+		oldValues[ev.Name] = ev
+
+		newValue, ok := newValues[ev.Name]
+		path := name + "." + ev.Name
+		switch {
+		case !ok:
+			c.add(SeverityBreaking, EnumValueRemoved, path, ev.Location,
+				"enum value %s was removed", path)
+		case !oldTC.ShouldSkipEnumValue(ev) && newTC.ShouldSkipEnumValue(newValue):
+			c.add(SeverityBreaking, JavaFieldRemoved, path, newValue.Location,
+				"enum constant %s is no longer generated", path)
+		default:
+			oldJava := oldTC.NamingHelper.GetEnumValueName(ev)
+			newJava := newTC.NamingHelper.GetEnumValueName(newValue)
+			if oldJava != newJava {
+				c.add(SeverityBreaking, JavaEnumValueRenamed, path, newValue.Location,
+					"Java enum constant %s was renamed from %s to %s", path, oldJava, newJava)
+			}
+		}
+	}
+
+	for _, ev := range newTC.TypeDef.EnumValues {
+		if _, ok := oldValues[ev.Name]; !ok {
+			path := name + "." + ev.Name
+			c.add(SeverityDangerous, EnumValueAdded, path, ev.Location,
+				"enum value %s was added; switch statements over %s may not handle it", path, name)
+		}
+	}
+}
+
+func (c *comparison) compareFields(oldTC, newTC *generator.TypeContext) {
+	typeName := newTC.TypeDef.Name
+	isInput := newTC.TypeDef.Kind == parser.TypeKindInputObject
+
+	for _, oldField := range oldTC.TypeDef.Fields {
+		if isHiddenField(oldTC, oldField) {
+			continue
+		}
+		path := typeName + "." + oldField.Name
+		newField := newTC.TypeDef.GetField(oldField.Name)
+		if newField == nil {
+			c.add(SeverityBreaking, FieldRemoved, path, oldField.Location,
+				"field %s was removed", path)
+			continue
+		}
+		c.compareField(oldTC, newTC, oldField, newField, isInput)
+	}
+
+	for _, newField := range newTC.TypeDef.Fields {
+		if isHiddenField(newTC, newField) || oldTC.TypeDef.GetField(newField.Name) != nil {
+			continue
+		}
+		path := typeName + "." + newField.Name
+		if isInput && isRequired(newField.Type, newField.DefaultValue) {
+			c.add(SeverityBreaking, RequiredInputFieldAdded, path, newField.Location,
+				"required input field %s was added", path)
+		} else {
+			c.add(SeveritySafe, FieldAdded, path, newField.Location,
+				"field %s was added", path)
+		}
+	}
+}
+
+func (c *comparison) compareField(oldTC, newTC *generator.TypeContext, oldField, newField *parser.FieldDef, isInput bool) {
+	path := newTC.TypeDef.Name + "." + newField.Name
+
+	graphQLBreaking := c.compareTypeRefs(path, "field", oldField.Type, newField.Type, isInput, newField.Location)
+
+	if isInput && !reflect.DeepEqual(oldField.DefaultValue, newField.DefaultValue) {
+		c.add(SeverityDangerous, DefaultValueChanged, path, newField.Location,
+			"default value of %s changed from %v to %v", path, oldField.DefaultValue, newField.DefaultValue)
+	}
+
+	oldFC, oldErr := generator.NewFieldContext(oldTC, oldField)
+	newFC, newErr := generator.NewFieldContext(newTC, newField)
+	if oldErr == nil && newErr == nil {
+		switch {
+		case oldFC.ShouldSkip():
+			// Not generated before, nothing Java code could depend on
+		case newFC.ShouldSkip():
+			c.add(SeverityBreaking, JavaFieldRemoved, path, newField.Location,
+				"Java field %s.%s is no longer generated", newTC.TypeName, oldFC.FieldName)
+		default:
+			if oldFC.FieldName != newFC.FieldName {
+				c.add(SeverityBreaking, JavaFieldRenamed, path, newField.Location,
+					"Java field %s was renamed from %s to %s", path, oldFC.FieldName, newFC.FieldName)
+			}
+			if !graphQLBreaking && oldFC.JavaType != newFC.JavaType {
+				c.add(SeverityBreaking, JavaFieldTypeChanged, path, newField.Location,
+					"Java type of %s changed from %s to %s", path, oldFC.JavaType, newFC.JavaType)
+			}
+		}
+	}
+
+	c.compareArguments(path, oldField, newField)
+}
+
+func (c *comparison) compareArguments(fieldPath string, oldField, newField *parser.FieldDef) {
+	newArgs := make(map[string]*parser.ArgumentDef)
+	for _, arg := range newField.Arguments {
+		newArgs[arg.Name] = arg
+	}
+	oldArgs := make(map[string]*parser.ArgumentDef)
+	for _, oldArg := range oldField.Arguments {
+		oldArgs[oldArg.Name] = oldArg
+		path := fieldPath + "(" + oldArg.Name + ")"
+
+		newArg, ok := newArgs[oldArg.Name]
+		if !ok {
+			c.add(SeverityBreaking, ArgumentRemoved, path, oldArg.Location,
+				"argument %s was removed", path)
+			continue
+		}
+
+		c.compareTypeRefs(path, "argument", oldArg.Type, newArg.Type, true, newArg.Location)
+		if !reflect.DeepEqual(oldArg.DefaultValue, newArg.DefaultValue) {
+			c.add(SeverityDangerous, DefaultValueChanged, path, newArg.Location,
+				"default value of %s changed from %v to %v", path, oldArg.DefaultValue, newArg.DefaultValue)
+		}
+	}
+
+	for _, newArg := range newField.Arguments {
+		if _, ok := oldArgs[newArg.Name]; ok {
+			continue
+		}
+		path := fieldPath + "(" + newArg.Name + ")"
+		if isRequired(newArg.Type, newArg.DefaultValue) {
+			c.add(SeverityBreaking, RequiredArgumentAdded, path, newArg.Location,
+				"required argument %s was added", path)
+		} else {
+			c.add(SeveritySafe, ArgumentAdded, path, newArg.Location,
+				"argument %s was added", path)
+		}
+	}
+}
+
+// compareTypeRefs records a change of a field or argument type and reports
+// whether it is breaking. Output positions may become stricter, input
+// positions (arguments and input fields) may become more lenient.
+func (c *comparison) compareTypeRefs(path, what string, oldRef, newRef *parser.TypeRef, isInput bool, loc *errors.Location) bool {
+	if reflect.DeepEqual(oldRef, newRef) {
+		return false
+	}
+
+	var compatible bool
+	if isInput {
+		compatible = isSafeInputChange(oldRef, newRef)
+	} else {
+		compatible = isSafeOutputChange(oldRef, newRef)
+	}
+
+	severity := SeveritySafe
+	if !compatible {
+		severity = SeverityBreaking
+	}
+
+	kind := FieldTypeChanged
+	if sameShape(oldRef, newRef) {
+		tightened, loosened := nullabilityChanges(oldRef, newRef)
+		switch {
+		case tightened && !loosened:
+			kind = NullabilityTightened
+		case loosened && !tightened:
+			kind = NullabilityLoosened
+		}
+	}
+
+	c.add(severity, kind, path, loc, "type of %s %s changed from %s to %s", what, path, oldRef, newRef)
+	return !compatible
+}
+
+// isSafeOutputChange reports whether clients reading a value of the old type
+// can read a value of the new type.
+func isSafeOutputChange(oldRef, newRef *parser.TypeRef) bool {
+	if oldRef == nil || newRef == nil {
+		return oldRef == newRef
+	}
+	if oldRef.NonNull && !newRef.NonNull {
+		return false
+	}
+	if oldRef.IsList() != newRef.IsList() {
+		return false
+	}
+	if oldRef.IsList() {
+		return isSafeOutputChange(oldRef.Elem, newRef.Elem)
+	}
+	return oldRef.Name == newRef.Name
+}
+
+// isSafeInputChange reports whether every value clients sent for the old
+// type is still valid for the new type.
+func isSafeInputChange(oldRef, newRef *parser.TypeRef) bool {
+	if oldRef == nil || newRef == nil {
+		return oldRef == newRef
+	}
+	if !oldRef.NonNull && newRef.NonNull {
+		return false
+	}
+	if oldRef.IsList() != newRef.IsList() {
+		return false
+	}
+	if oldRef.IsList() {
+		return isSafeInputChange(oldRef.Elem, newRef.Elem)
+	}
+	return oldRef.Name == newRef.Name
+}
+
+// sameShape reports whether two types differ in nullability only.
+func sameShape(a, b *parser.TypeRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IsList() != b.IsList() {
+		return false
+	}
+	if a.IsList() {
+		return sameShape(a.Elem, b.Elem)
+	}
+	return a.Name == b.Name
+}
+
+func nullabilityChanges(oldRef, newRef *parser.TypeRef) (tightened, loosened bool) {
+	for oldRef != nil && newRef != nil {
+		if !oldRef.NonNull && newRef.NonNull {
+			tightened = true
+		}
+		if oldRef.NonNull && !newRef.NonNull {
+			loosened = true
+		}
+		oldRef, newRef = oldRef.Elem, newRef.Elem
+	}
+	return tightened, loosened
+}
+
+func isRequired(typeRef *parser.TypeRef, defaultValue interface{}) bool {
+	return typeRef != nil && typeRef.NonNull && defaultValue == nil
+}
+
+// isHiddenField reports whether the field is an introspection or federation
+// field that never reaches the generated code.
+func isHiddenField(tc *generator.TypeContext, field *parser.FieldDef) bool {
+	if len(field.Name) >= 2 && field.Name[:2] == "__" {
+		return true
+	}
+	return parser.IsFederationQueryField(field.Name) && tc.Schema.IsRootType(tc.TypeDef.Name)
+}
+
+// typeNames returns the names of all types in either schema, sorted.
+// Federation infrastructure types are left out.
+func typeNames(oldSchema, newSchema *parser.Schema) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, schema := range []*parser.Schema{oldSchema, newSchema} {
+		for name := range schema.Types {
+			if !seen[name] && !parser.IsFederationType(name) {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// missing returns the elements of a that are not in b.
+func missing(a, b []string) []string {
+	present := make(map[string]bool, len(b))
+	for _, s := range b {
+		present[s] = true
+	}
+	var result []string
+	for _, s := range a {
+		if !present[s] {
+			result = append(result, s)
+		}
+	}
+	return result
+}
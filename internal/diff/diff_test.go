@@ -0,0 +1,162 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+const javaNameDirective = "directive @javaName(name: String!) on OBJECT | FIELD_DEFINITION | ENUM_VALUE\n"
+
+func compareSDL(t *testing.T, oldSDL, newSDL string) *Report {
+	return compareWithConfigs(t, oldSDL, newSDL, config.DefaultConfig(), config.DefaultConfig())
+}
+
+func compareWithConfigs(t *testing.T, oldSDL, newSDL string, oldCfg, newCfg *config.Config) *Report {
+	p := parser.NewParser()
+	oldSchema, err := p.Parse(javaNameDirective+oldSDL, "old.graphql")
+	require.NoError(t, err)
+	newSchema, err := p.Parse(javaNameDirective+newSDL, "new.graphql")
+	require.NoError(t, err)
+
+	return Compare(oldSchema, newSchema, oldCfg, newCfg)
+}
+
+// findChange returns the change of the given kind at the given path.
+func findChange(report *Report, kind, path string) *Change {
+	for _, c := range report.Changes {
+		if c.Kind == kind && c.Path == path {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestCompare_NoChanges(t *testing.T) {
+	sdl := `type Query { user: User } type User { id: ID! }`
+	report := compareSDL(t, sdl, sdl)
+
+	assert.Empty(t, report.Changes)
+	assert.False(t, report.HasBreaking())
+}
+
+func TestCompare_Types(t *testing.T) {
+	report := compareSDL(t,
+		`type User { id: ID! } type Legacy { x: Int } type Gone { x: Int }`,
+		`type User { id: ID! } interface Legacy { x: Int } type Post { x: Int }`)
+
+	assert.Equal(t, SeverityBreaking, findChange(report, TypeRemoved, "Gone").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, TypeKindChanged, "Legacy").Severity)
+	assert.Equal(t, SeveritySafe, findChange(report, TypeAdded, "Post").Severity)
+	assert.Equal(t, Summary{Breaking: 2, Safe: 1}, report.Summary)
+}
+
+func TestCompare_Fields(t *testing.T) {
+	report := compareSDL(t,
+		`type User { id: ID!, name: String, email: String!, login: String }`,
+		`type User { id: ID!, name: String!, email: String, nickname: String }`)
+
+	assert.Equal(t, SeverityBreaking, findChange(report, FieldRemoved, "User.login").Severity)
+	assert.Equal(t, SeveritySafe, findChange(report, FieldAdded, "User.nickname").Severity)
+	assert.Equal(t, SeveritySafe, findChange(report, NullabilityTightened, "User.name").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, NullabilityLoosened, "User.email").Severity)
+
+	removed := findChange(report, FieldRemoved, "User.login")
+	require.NotNil(t, removed.Location)
+	assert.Equal(t, "old.graphql", removed.Location.File)
+}
+
+func TestCompare_InputFieldsAndArguments(t *testing.T) {
+	report := compareSDL(t,
+		`type Query { users(first: Int, after: String): [String] } input Filter { name: String, role: String = "admin" }`,
+		`type Query { users(first: Int!, sort: String, limit: Int!): [String] } input Filter { name: String!, role: String = "user", team: ID!, tag: String }`)
+
+	assert.Equal(t, SeverityBreaking, findChange(report, NullabilityTightened, "Query.users(first)").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, ArgumentRemoved, "Query.users(after)").Severity)
+	assert.Equal(t, SeveritySafe, findChange(report, ArgumentAdded, "Query.users(sort)").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, RequiredArgumentAdded, "Query.users(limit)").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, NullabilityTightened, "Filter.name").Severity)
+	assert.Equal(t, SeverityDangerous, findChange(report, DefaultValueChanged, "Filter.role").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, RequiredInputFieldAdded, "Filter.team").Severity)
+	assert.Equal(t, SeveritySafe, findChange(report, FieldAdded, "Filter.tag").Severity)
+}
+
+func TestCompare_FieldTypeChanged(t *testing.T) {
+	report := compareSDL(t,
+		`type User { age: Int, tags: [String] }`,
+		`type User { age: String, tags: String }`)
+
+	assert.Equal(t, SeverityBreaking, findChange(report, FieldTypeChanged, "User.age").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, FieldTypeChanged, "User.tags").Severity)
+	// The GraphQL change is already breaking, no separate Java change
+	assert.Nil(t, findChange(report, JavaFieldTypeChanged, "User.age"))
+}
+
+func TestCompare_EnumsUnionsInterfaces(t *testing.T) {
+	report := compareSDL(t,
+		`enum Role { ADMIN, GUEST } union Result = A interface Node { id: ID } type A implements Node { id: ID } type B { id: ID }`,
+		`enum Role { ADMIN, OWNER } union Result = A | B interface Node { id: ID } type A { id: ID } type B implements Node { id: ID }`)
+
+	assert.Equal(t, SeverityBreaking, findChange(report, EnumValueRemoved, "Role.GUEST").Severity)
+	assert.Equal(t, SeverityDangerous, findChange(report, EnumValueAdded, "Role.OWNER").Severity)
+	assert.Equal(t, SeverityDangerous, findChange(report, UnionMemberAdded, "Result").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, InterfaceRemoved, "A").Severity)
+	assert.Equal(t, SeveritySafe, findChange(report, InterfaceAdded, "B").Severity)
+}
+
+func TestCompare_JavaRenames(t *testing.T) {
+	report := compareSDL(t,
+		`type Query { user: User } type User { login: String } enum Role { ADMIN }`,
+		`type Query { user: User } type User @javaName(name: "Account") { login: String @javaName(name: "username") } enum Role { ADMIN @javaName(name: "ADMINISTRATOR") }`)
+
+	assert.Equal(t, SeverityBreaking, findChange(report, JavaTypeRenamed, "User").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, JavaFieldRenamed, "User.login").Severity)
+	assert.Equal(t, SeverityBreaking, findChange(report, JavaEnumValueRenamed, "Role.ADMIN").Severity)
+
+	// Fields referencing the renamed class change their Java type
+	change := findChange(report, JavaFieldTypeChanged, "Query.user")
+	require.NotNil(t, change)
+	assert.Contains(t, change.Message, "from User to Account")
+}
+
+func TestCompare_JavaNullabilityMapping(t *testing.T) {
+	// Int -> Int! is safe for GraphQL clients, but Integer becomes int in Java
+	report := compareSDL(t, `type User { age: Int }`, `type User { age: Int! }`)
+
+	assert.Equal(t, SeveritySafe, findChange(report, NullabilityTightened, "User.age").Severity)
+	change := findChange(report, JavaFieldTypeChanged, "User.age")
+	require.NotNil(t, change)
+	assert.Equal(t, SeverityBreaking, change.Severity)
+	assert.Contains(t, change.Message, "from Integer to int")
+}
+
+func TestCompare_ScalarMappingChanged(t *testing.T) {
+	sdl := `scalar DateTime type Event { at: DateTime }`
+	newCfg := config.DefaultConfig()
+	newCfg.TypeMappings.Scalars["DateTime"] = config.ScalarMapping{
+		JavaType: "OffsetDateTime",
+		Imports:  []string{"java.time.OffsetDateTime"},
+	}
+
+	report := compareWithConfigs(t, sdl, sdl, config.DefaultConfig(), newCfg)
+
+	scalar := findChange(report, ScalarMappingChanged, "DateTime")
+	require.NotNil(t, scalar)
+	assert.Contains(t, scalar.Message, "now maps to OffsetDateTime instead of LocalDateTime")
+	assert.NotNil(t, findChange(report, JavaFieldTypeChanged, "Event.at"))
+}
+
+func TestCompare_SortedBySeverity(t *testing.T) {
+	report := compareSDL(t,
+		`type A { x: Int } enum Role { ADMIN }`,
+		`type B { x: Int } enum Role { ADMIN, USER }`)
+
+	require.Len(t, report.Changes, 3)
+	assert.Equal(t, SeverityBreaking, report.Changes[0].Severity)
+	assert.Equal(t, SeverityDangerous, report.Changes[1].Severity)
+	assert.Equal(t, SeveritySafe, report.Changes[2].Severity)
+}
@@ -21,6 +21,9 @@ const (
 
 	// CodeTypemap indicates a type mapping error.
 	CodeTypemap ErrorCode = "TYPEMAP"
+
+	// CodeLint indicates a schema lint finding.
+	CodeLint ErrorCode = "LINT"
 )
 
 // String returns the string representation of the error code.
@@ -0,0 +1,149 @@
+package errors
+
+import "strings"
+
+// Diagnostic is the machine-readable form of an error or finding, used for
+// JSON and SARIF output.
+type Diagnostic struct {
+	Code      ErrorCode `json:"code,omitempty"`
+	Severity  string    `json:"severity"`
+	Message   string    `json:"message"`
+	File      string    `json:"file,omitempty"`
+	Line      int       `json:"line,omitempty"`
+	Column    int       `json:"column,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Field     string    `json:"field,omitempty"`
+	Directive string    `json:"directive,omitempty"`
+	Rule      string    `json:"rule,omitempty"`
+	Hint      string    `json:"hint,omitempty"`
+}
+
+// defaultSeverity is the severity of every error that is not a lint finding.
+const defaultSeverity = "error"
+
+// Diagnostics converts an error into diagnostics. Error collections yield
+// one diagnostic per collected error. Wrapped errors are reported as the
+// outermost typed error, with type, field and location taken from the first
+// error in the chain that has them.
+func Diagnostics(err error) []*Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	if collection := findCollection(err); collection != nil {
+		var diagnostics []*Diagnostic
+		for _, e := range collection.Errors() {
+			diagnostics = append(diagnostics, Diagnostics(e)...)
+		}
+		return diagnostics
+	}
+
+	d := &Diagnostic{Severity: defaultSeverity}
+	var messages []string
+	for e := err; e != nil; e = unwrap(e) {
+		base := baseError(e)
+		if base == nil {
+			if d.Code == "" {
+				// Plain error ahead of the typed ones, e.g. fmt.Errorf wrapping
+				continue
+			}
+			messages = append(messages, e.Error())
+			break
+		}
+
+		if d.Code == "" {
+			d.Code = base.Code
+		}
+		messages = append(messages, base.Message)
+		d.fill(e, base)
+		if d.Hint == "" {
+			d.Hint = base.Hint
+		}
+		if base.Location != nil && d.File == "" {
+			d.File = base.Location.File
+			d.Line = base.Location.Line
+			d.Column = base.Location.Column
+		}
+		if base.Cause == nil {
+			break
+		}
+	}
+
+	if d.Code == "" {
+		// No typed error in the chain
+		d.Message = err.Error()
+	} else {
+		d.Message = strings.Join(messages, ": ")
+	}
+	return []*Diagnostic{d}
+}
+
+// fill copies the context of err into d where not yet set. The context is
+// read from the Context map, which the With* methods populate, so it survives
+// WithLocation returning the embedded GeneratorError.
+func (d *Diagnostic) fill(err error, base *GeneratorError) {
+	setIfEmpty := func(dst *string, keys ...string) {
+		for _, key := range keys {
+			if value, ok := base.Context[key].(string); ok && *dst == "" {
+				*dst = value
+			}
+		}
+	}
+
+	setIfEmpty(&d.Type, "type", "sourceType")
+	setIfEmpty(&d.Field, "field")
+	setIfEmpty(&d.Directive, "directive")
+	setIfEmpty(&d.Rule, "rule")
+	if base.Code == CodeOutput {
+		setIfEmpty(&d.File, "file")
+	}
+
+	if lintErr, ok := err.(*LintError); ok {
+		d.Severity = lintErr.Severity
+	}
+}
+
+// baseError returns the GeneratorError embedded in err, or nil if err is not
+// one of the typed errors of this package.
+func baseError(err error) *GeneratorError {
+	switch e := err.(type) {
+	case *GeneratorError:
+		return e
+	case *ConfigError:
+		return &e.GeneratorError
+	case *ParseError:
+		return &e.GeneratorError
+	case *GenerateError:
+		return &e.GeneratorError
+	case *DirectiveError:
+		return &e.GeneratorError
+	case *TypeMappingError:
+		return &e.GeneratorError
+	case *OutputError:
+		return &e.GeneratorError
+	case *LintError:
+		return &e.GeneratorError
+	}
+	return nil
+}
+
+// findCollection returns the first ErrorCollection in the chain of err
+// before any typed error.
+func findCollection(err error) *ErrorCollection {
+	for e := err; e != nil; e = unwrap(e) {
+		if collection, ok := e.(*ErrorCollection); ok {
+			return collection
+		}
+		if baseError(e) != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+func unwrap(err error) error {
+	if u, ok := err.(interface{ Unwrap() error }); ok {
+		return u.Unwrap()
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnostics_Nil(t *testing.T) {
+	assert.Nil(t, Diagnostics(nil))
+}
+
+func TestDiagnostics_TypedErrors(t *testing.T) {
+	loc := &Location{File: "schema.graphql", Line: 3, Column: 5}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected *Diagnostic
+	}{
+		{
+			name: "config error",
+			err:  NewConfigError("unsupported Java version", nil).WithField("java.version"),
+			expected: &Diagnostic{
+				Code: CodeConfig, Severity: "error", Message: "unsupported Java version", Field: "java.version",
+			},
+		},
+		{
+			name: "parse error with location",
+			err:  NewParseError("Undefined type Post.", nil).WithLocation(loc),
+			expected: &Diagnostic{
+				Code: CodeParse, Severity: "error", Message: "Undefined type Post.",
+				File: "schema.graphql", Line: 3, Column: 5,
+			},
+		},
+		{
+			name: "generate error keeps context after WithLocation",
+			err:  NewGenerateError("failed", nil).WithTypeName("User").WithFieldName("name").WithLocation(loc),
+			expected: &Diagnostic{
+				Code: CodeGenerate, Severity: "error", Message: "failed",
+				File: "schema.graphql", Line: 3, Column: 5, Type: "User", Field: "name",
+			},
+		},
+		{
+			name: "directive error",
+			err:  NewDirectiveError("bad argument", nil).WithDirective("javaName").WithTypeName("User"),
+			expected: &Diagnostic{
+				Code: CodeDirective, Severity: "error", Message: "bad argument", Type: "User", Directive: "javaName",
+			},
+		},
+		{
+			name: "type mapping error",
+			err:  NewTypeMappingError("unknown type", nil).WithSourceType("Money"),
+			expected: &Diagnostic{
+				Code: CodeTypemap, Severity: "error", Message: "unknown type", Type: "Money",
+			},
+		},
+		{
+			name: "output error",
+			err:  NewOutputError("failed to write file", nil).WithFilePath("out/User.java"),
+			expected: &Diagnostic{
+				Code: CodeOutput, Severity: "error", Message: "failed to write file", File: "out/User.java",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := Diagnostics(tt.err)
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, tt.expected, diagnostics[0])
+		})
+	}
+}
+
+func TestDiagnostics_LintError(t *testing.T) {
+	finding := NewLintError("naming-convention", "warning", "type name should be PascalCase").WithTypeName("user")
+	finding.Location = &Location{File: "schema.graphql", Line: 2}
+
+	diagnostics := Diagnostics(finding)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "warning", diagnostics[0].Severity)
+	assert.Equal(t, "naming-convention", diagnostics[0].Rule)
+	assert.Equal(t, "user", diagnostics[0].Type)
+	assert.Equal(t, 2, diagnostics[0].Line)
+}
+
+func TestDiagnostics_WrappedChain(t *testing.T) {
+	inner := NewTypeMappingError("failed to map named type", fmt.Errorf("no mapping")).WithSourceType("Money")
+	outer := NewGenerateError("failed to create field context", inner).
+		WithTypeName("Order").
+		WithFieldName("total").
+		WithLocation(&Location{File: "schema.graphql", Line: 7, Column: 3})
+
+	diagnostics := Diagnostics(fmt.Errorf("Error generating code: %w", outer))
+	require.Len(t, diagnostics, 1)
+
+	d := diagnostics[0]
+	assert.Equal(t, CodeGenerate, d.Code)
+	assert.Equal(t, "failed to create field context: failed to map named type: no mapping", d.Message)
+	assert.Equal(t, "Order", d.Type)
+	assert.Equal(t, "total", d.Field)
+	assert.Equal(t, 7, d.Line)
+}
+
+func TestDiagnostics_Collection(t *testing.T) {
+	errs := NewErrorCollection()
+	errs.Add(NewConfigError("first", nil).WithField("java.version"))
+	errs.Add(NewConfigError("second", nil).WithField("output.package"))
+
+	diagnostics := Diagnostics(fmt.Errorf("Configuration error: %w", errs))
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, "first", diagnostics[0].Message)
+	assert.Equal(t, "output.package", diagnostics[1].Field)
+}
+
+func TestDiagnostics_PlainError(t *testing.T) {
+	diagnostics := Diagnostics(fmt.Errorf("something failed"))
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, ErrorCode(""), diagnostics[0].Code)
+	assert.Equal(t, "error", diagnostics[0].Severity)
+	assert.Equal(t, "something failed", diagnostics[0].Message)
+}
+
+func TestDiagnostics_Hint(t *testing.T) {
+	err := NewParseError("Undefined type Psot.", nil)
+	err.WithHint("did you mean Post?")
+
+	diagnostics := Diagnostics(fmt.Errorf("Error parsing schema: %w", err))
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "did you mean Post?", diagnostics[0].Hint)
+}
@@ -7,9 +7,9 @@ import (
 
 // Location represents a position in a source file.
 type Location struct {
-	File   string
-	Line   int
-	Column int
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
 }
 
 // String returns a formatted location string.
@@ -33,6 +33,7 @@ type GeneratorError struct {
 	Cause    error
 	Context  map[string]interface{}
 	Location *Location
+	Hint     string
 }
 
 // Error implements the error interface.
@@ -77,6 +78,12 @@ func (e *GeneratorError) WithLocation(loc *Location) *GeneratorError {
 	return e
 }
 
+// WithHint sets a suggestion for fixing the error and returns it.
+func (e *GeneratorError) WithHint(hint string) *GeneratorError {
+	e.Hint = hint
+	return e
+}
+
 // ConfigError represents a configuration error.
 type ConfigError struct {
 	GeneratorError
@@ -283,6 +290,48 @@ func (e *OutputError) WithFilePath(path string) *OutputError {
 	return e
 }
 
+// LintError represents a schema lint finding.
+type LintError struct {
+	GeneratorError
+	Rule      string
+	Severity  string
+	TypeName  string
+	FieldName string
+}
+
+// NewLintError creates a new lint error for the given rule.
+func NewLintError(rule, severity, message string) *LintError {
+	return &LintError{
+		GeneratorError: GeneratorError{
+			Code:    CodeLint,
+			Message: message,
+			Context: map[string]interface{}{"rule": rule},
+		},
+		Rule:     rule,
+		Severity: severity,
+	}
+}
+
+// WithTypeName sets the type name.
+func (e *LintError) WithTypeName(typeName string) *LintError {
+	e.TypeName = typeName
+	if e.Context == nil {
+		e.Context = make(map[string]interface{})
+	}
+	e.Context["type"] = typeName
+	return e
+}
+
+// WithFieldName sets the field name.
+func (e *LintError) WithFieldName(fieldName string) *LintError {
+	e.FieldName = fieldName
+	if e.Context == nil {
+		e.Context = make(map[string]interface{})
+	}
+	e.Context["field"] = fieldName
+	return e
+}
+
 // ErrorCollection collects multiple errors for batch reporting.
 type ErrorCollection struct {
 	errors []error
@@ -222,6 +222,18 @@ func TestOutputError_WithFilePath(t *testing.T) {
 	assert.Equal(t, "/path/to/file.java", err.Context["file"])
 }
 
+func TestNewLintError(t *testing.T) {
+	err := NewLintError("naming-convention", "warning", "type name should be PascalCase")
+	err.WithTypeName("user_account").WithFieldName("id")
+
+	assert.Equal(t, CodeLint, err.Code)
+	assert.Equal(t, "naming-convention", err.Rule)
+	assert.Equal(t, "warning", err.Severity)
+	assert.Equal(t, "user_account", err.TypeName)
+	assert.Equal(t, "id", err.FieldName)
+	assert.Equal(t, "naming-convention", err.Context["rule"])
+}
+
 func TestErrorCollection_Empty(t *testing.T) {
 	ec := NewErrorCollection()
 
@@ -0,0 +1,91 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SourceReader returns the content of a source file, used to show the
+// offending line when rendering diagnostics.
+type SourceReader func(file string) ([]byte, error)
+
+// Render writes diagnostics in a human readable form: the message, the
+// location, the offending source line with a caret under the column and a
+// hint if one is known.
+//
+//	error[PARSE]: Undefined type Psot.
+//	 --> schema.graphql:4:11
+//	  |
+//	4 |   posts: [Psot]
+//	  |           ^
+//	  = hint: did you mean Post?
+//
+// The source line is omitted if read is nil or cannot provide it.
+func Render(w io.Writer, diagnostics []*Diagnostic, read SourceReader) {
+	for _, d := range diagnostics {
+		renderDiagnostic(w, d, read)
+	}
+}
+
+func renderDiagnostic(w io.Writer, d *Diagnostic, read SourceReader) {
+	if d.Code != "" {
+		fmt.Fprintf(w, "%s[%s]: %s\n", d.Severity, d.Code, d.Message)
+	} else {
+		fmt.Fprintf(w, "%s: %s\n", d.Severity, d.Message)
+	}
+
+	line := ""
+	if d.File != "" && d.Line > 0 && read != nil {
+		line, _ = sourceLine(read, d.File, d.Line)
+	}
+
+	gutter := strings.Repeat(" ", len(strconv.Itoa(d.Line)))
+	if d.File != "" {
+		loc := &Location{File: d.File, Line: d.Line, Column: d.Column}
+		fmt.Fprintf(w, "%s--> %s\n", gutter, loc)
+	}
+
+	if line != "" {
+		fmt.Fprintf(w, "%s |\n", gutter)
+		fmt.Fprintf(w, "%d | %s\n", d.Line, line)
+		if d.Column > 0 {
+			fmt.Fprintf(w, "%s | %s^\n", gutter, caretPadding(line, d.Column))
+		}
+	}
+
+	if d.Hint != "" {
+		fmt.Fprintf(w, "%s = hint: %s\n", gutter, d.Hint)
+	}
+}
+
+// sourceLine returns the given 1-based line of a file without its line ending.
+func sourceLine(read SourceReader, file string, lineNumber int) (string, bool) {
+	data, err := read(file)
+	if err != nil {
+		return "", false
+	}
+	lines := strings.Split(string(data), "\n")
+	if lineNumber > len(lines) {
+		return "", false
+	}
+	return strings.TrimRight(lines[lineNumber-1], "\r"), true
+}
+
+// caretPadding returns the whitespace that places a caret under the given
+// 1-based column of line, keeping tabs so the caret lines up.
+func caretPadding(line string, column int) string {
+	var sb strings.Builder
+	for i, r := range []rune(line) {
+		if i >= column-1 {
+			break
+		}
+		if r == '\t' {
+			sb.WriteRune('\t')
+		} else {
+			sb.WriteRune(' ')
+		}
+	}
+	return sb.String()
+}
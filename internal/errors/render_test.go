@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender_WithSourceAndHint(t *testing.T) {
+	read := func(file string) ([]byte, error) {
+		assert.Equal(t, "schema.graphql", file)
+		return []byte("type Query { user: User }\r\ntype User {\n\tposts: [Psot]\n}\n"), nil
+	}
+
+	var sb strings.Builder
+	Render(&sb, []*Diagnostic{{
+		Code: CodeParse, Severity: "error", Message: "Undefined type Psot.",
+		File: "schema.graphql", Line: 3, Column: 10, Hint: "did you mean Post?",
+	}}, read)
+
+	expected := "error[PARSE]: Undefined type Psot.\n" +
+		" --> schema.graphql:3:10\n" +
+		"  |\n" +
+		"3 | \tposts: [Psot]\n" +
+		"  | \t        ^\n" +
+		"  = hint: did you mean Post?\n"
+	assert.Equal(t, expected, sb.String())
+}
+
+func TestRender_WithoutSource(t *testing.T) {
+	read := func(file string) ([]byte, error) {
+		return nil, fmt.Errorf("not found")
+	}
+
+	var sb strings.Builder
+	Render(&sb, []*Diagnostic{
+		{Code: CodeTypemap, Severity: "warning", Message: "scalar Money has no Java mapping", File: "gone.graphql", Line: 12},
+		{Severity: "error", Message: "plain failure"},
+	}, read)
+
+	expected := "warning[TYPEMAP]: scalar Money has no Java mapping\n" +
+		"  --> gone.graphql:12\n" +
+		"error: plain failure\n"
+	assert.Equal(t, expected, sb.String())
+}
+
+func TestRender_LineOutOfRange(t *testing.T) {
+	read := func(file string) ([]byte, error) {
+		return []byte("type Query { id: ID }"), nil
+	}
+
+	var sb strings.Builder
+	Render(&sb, []*Diagnostic{{Code: CodeParse, Severity: "error", Message: "bad", File: "a.graphql", Line: 5, Column: 1}}, read)
+
+	assert.Equal(t, "error[PARSE]: bad\n --> a.graphql:5:1\n", sb.String())
+}
@@ -0,0 +1,156 @@
+package errors
+
+import (
+	"path/filepath"
+	"sort"
+)
+
+// SARIF version and schema written by NewSARIFLog.
+const (
+	SARIFVersion = "2.1.0"
+	SARIFSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// SARIFLog is a SARIF 2.1.0 log, the format code review tools read to show
+// findings as inline annotations.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun is a single run of a tool.
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+// SARIFTool describes the tool that produced a run.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver is the tool component that produced the results.
+type SARIFDriver struct {
+	Name    string      `json:"name"`
+	Version string      `json:"version,omitempty"`
+	Rules   []SARIFRule `json:"rules,omitempty"`
+}
+
+// SARIFRule describes a rule referenced by results.
+type SARIFRule struct {
+	ID string `json:"id"`
+}
+
+// SARIFResult is a single diagnostic.
+type SARIFResult struct {
+	RuleID     string                 `json:"ruleId"`
+	Level      string                 `json:"level"`
+	Message    SARIFMessage           `json:"message"`
+	Locations  []SARIFLocation        `json:"locations,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// SARIFMessage is the text of a result.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFLocation is the location of a result.
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+// SARIFPhysicalLocation is a location in a file.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+	Region           *SARIFRegion          `json:"region,omitempty"`
+}
+
+// SARIFArtifactLocation identifies a file.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFRegion is a position within a file.
+type SARIFRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// NewSARIFLog creates a SARIF log with one run of the given tool containing
+// the diagnostics. Lint findings use their rule as rule ID, other diagnostics
+// their error code.
+func NewSARIFLog(toolName, toolVersion string, diagnostics []*Diagnostic) *SARIFLog {
+	results := make([]SARIFResult, 0, len(diagnostics))
+	ruleIDs := make(map[string]bool)
+
+	for _, d := range diagnostics {
+		ruleID := d.Rule
+		if ruleID == "" {
+			ruleID = d.Code.String()
+		}
+		ruleIDs[ruleID] = true
+
+		text := d.Message
+		if d.Hint != "" {
+			text += " (" + d.Hint + ")"
+		}
+		result := SARIFResult{
+			RuleID:  ruleID,
+			Level:   sarifLevel(d.Severity),
+			Message: SARIFMessage{Text: text},
+		}
+
+		if d.File != "" {
+			location := SARIFPhysicalLocation{
+				ArtifactLocation: SARIFArtifactLocation{URI: filepath.ToSlash(d.File)},
+			}
+			if d.Line > 0 {
+				location.Region = &SARIFRegion{StartLine: d.Line, StartColumn: d.Column}
+			}
+			result.Locations = []SARIFLocation{{PhysicalLocation: location}}
+		}
+
+		properties := map[string]interface{}{}
+		for key, value := range map[string]string{
+			"code": d.Code.String(), "type": d.Type, "field": d.Field, "directive": d.Directive,
+		} {
+			if value != "" {
+				properties[key] = value
+			}
+		}
+		if len(properties) > 0 {
+			result.Properties = properties
+		}
+
+		results = append(results, result)
+	}
+
+	var rules []SARIFRule
+	for id := range ruleIDs {
+		rules = append(rules, SARIFRule{ID: id})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+
+	return &SARIFLog{
+		Schema:  SARIFSchema,
+		Version: SARIFVersion,
+		Runs: []SARIFRun{{
+			Tool:    SARIFTool{Driver: SARIFDriver{Name: toolName, Version: toolVersion, Rules: rules}},
+			Results: results,
+		}},
+	}
+}
+
+// sarifLevel maps a diagnostic severity to a SARIF result level.
+func sarifLevel(severity string) string {
+	switch severity {
+	case "warning":
+		return "warning"
+	case "info":
+		return "note"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSARIFLog(t *testing.T) {
+	diagnostics := []*Diagnostic{
+		{Code: CodeParse, Severity: "error", Message: "Undefined type Post.", File: "schema.graphql", Line: 4, Column: 11},
+		{Code: CodeLint, Severity: "info", Message: "type Query has no description", Rule: "missing-description", Type: "Query"},
+		{Code: CodeConfig, Severity: "error", Message: "schema path is required", Field: "schema.path"},
+	}
+
+	log := NewSARIFLog("gql2j", "1.0.1", diagnostics)
+
+	assert.Equal(t, SARIFVersion, log.Version)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	assert.Equal(t, "gql2j", run.Tool.Driver.Name)
+	assert.Equal(t, []SARIFRule{{ID: "CONFIG"}, {ID: "PARSE"}, {ID: "missing-description"}}, run.Tool.Driver.Rules)
+
+	require.Len(t, run.Results, 3)
+
+	parse := run.Results[0]
+	assert.Equal(t, "PARSE", parse.RuleID)
+	assert.Equal(t, "error", parse.Level)
+	require.Len(t, parse.Locations, 1)
+	assert.Equal(t, "schema.graphql", parse.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, &SARIFRegion{StartLine: 4, StartColumn: 11}, parse.Locations[0].PhysicalLocation.Region)
+
+	lint := run.Results[1]
+	assert.Equal(t, "missing-description", lint.RuleID)
+	assert.Equal(t, "note", lint.Level)
+	assert.Empty(t, lint.Locations)
+	assert.Equal(t, "Query", lint.Properties["type"])
+
+	config := run.Results[2]
+	assert.Equal(t, "schema.path", config.Properties["field"])
+}
+
+func TestNewSARIFLog_Empty(t *testing.T) {
+	log := NewSARIFLog("gql2j", "1.0.1", nil)
+
+	require.Len(t, log.Runs, 1)
+	assert.NotNil(t, log.Runs[0].Results)
+	assert.Empty(t, log.Runs[0].Results)
+}
+
+func TestNewSARIFLog_Hint(t *testing.T) {
+	log := NewSARIFLog("gql2j", "1.0.1", []*Diagnostic{
+		{Code: CodeParse, Severity: "error", Message: "Undefined type Psot.", Hint: "did you mean Post?"},
+	})
+
+	assert.Equal(t, "Undefined type Psot. (did you mean Post?)", log.Runs[0].Results[0].Message.Text)
+}
@@ -0,0 +1,72 @@
+package errors
+
+import "strings"
+
+// ClosestMatch returns the candidate most similar to name, or "" if none is
+// close enough to be a likely misspelling. Comparison ignores case and
+// counts swapped adjacent letters as a single edit. Among equally close
+// candidates, one that name abbreviates is preferred.
+func ClosestMatch(name string, candidates []string) string {
+	lowerName := strings.ToLower(name)
+	maxDistance := len([]rune(name))/3 + 1
+
+	best := ""
+	bestDistance := maxDistance + 1
+	for _, candidate := range candidates {
+		if candidate == name {
+			continue
+		}
+		lowerCandidate := strings.ToLower(candidate)
+		distance := editDistance(lowerName, lowerCandidate)
+		if distance < bestDistance || (distance == bestDistance && best != "" && tieBreak(lowerName, candidate, best)) {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+
+	if bestDistance > maxDistance {
+		return ""
+	}
+	return best
+}
+
+// tieBreak returns true if candidate is preferred over best as a match for
+// the lower case name: candidates name abbreviates come first, then the
+// lexicographically smaller one.
+func tieBreak(lowerName, candidate, best string) bool {
+	candidatePrefix := strings.HasPrefix(strings.ToLower(candidate), lowerName)
+	bestPrefix := strings.HasPrefix(strings.ToLower(best), lowerName)
+	if candidatePrefix != bestPrefix {
+		return candidatePrefix
+	}
+	return candidate < best
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b: insertions, deletions, substitutions and transpositions of adjacent
+// characters each count as one edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	rows := make([][]int, len(ra)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(rb)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(ra)][len(rb)]
+}
@@ -0,0 +1,41 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosestMatch(t *testing.T) {
+	directives := []string{"javaName", "javaType", "annotation", "constraint", "lombok", "collection", "skip"}
+
+	tests := []struct {
+		name       string
+		input      string
+		candidates []string
+		expected   string
+	}{
+		{"transposition", "Psot", []string{"Post", "Query", "User"}, "Post"},
+		{"missing letter", "javaNme", directives, "javaName"},
+		{"case only", "user", []string{"User", "Post"}, "User"},
+		{"extra letter", "constraints", directives, "constraint"},
+		{"abbreviation preferred on tie", "minLen", []string{"max", "min", "minLength"}, "minLength"},
+		{"too different", "cache", directives, ""},
+		{"exact match skipped", "Post", []string{"Post"}, ""},
+		{"no candidates", "Post", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClosestMatch(tt.input, tt.candidates))
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("post", "post"))
+	assert.Equal(t, 1, editDistance("psot", "post"))
+	assert.Equal(t, 1, editDistance("javanme", "javaname"))
+	assert.Equal(t, 3, editDistance("", "abc"))
+	assert.Equal(t, 2, editDistance("héllo", "hallo!"))
+}
@@ -10,12 +10,14 @@ import (
 // ClassGenerator generates Java classes.
 type ClassGenerator struct {
 	fieldGen *FieldGenerator
+	oneOfGen *OneOfGenerator
 }
 
 // NewClassGenerator creates a new class generator.
 func NewClassGenerator() *ClassGenerator {
 	return &ClassGenerator{
 		fieldGen: NewFieldGenerator(),
+		oneOfGen: NewOneOfGenerator(),
 	}
 }
 
@@ -62,9 +64,11 @@ func (g *ClassGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string
 			if i > 0 {
 				sb.WriteString(", ")
 			}
-			// Apply interface naming convention
+			// Apply interface naming convention and renames
 			ifaceName := iface
-			if ctx.Config.Java.Naming.InterfacePrefix != "" {
+			if ifaceDef := ctx.Schema.GetType(iface); ifaceDef != nil {
+				ifaceName = ctx.NamingHelper.GetTypeName(ifaceDef)
+			} else if ctx.Config.Java.Naming.InterfacePrefix != "" {
 				ifaceName = ctx.Config.Java.Naming.InterfacePrefix + iface
 			}
 			sb.WriteString(ifaceName)
@@ -73,6 +77,9 @@ func (g *ClassGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string
 
 	sb.WriteString(" {\n\n")
 
+	// Constants of the GraphQL names of the type and its fields
+	sb.WriteString(generateNestedConstants(tc, false))
+
 	// Generate fields
 	var fieldContexts []*FieldContext
 	for _, field := range typeDef.Fields {
@@ -80,7 +87,8 @@ func (g *ClassGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string
 		if err != nil {
 			return "", errors.NewGenerateError("failed to create field context", err).
 				WithTypeName(typeDef.Name).
-				WithFieldName(field.Name)
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
 		}
 
 		if fc.ShouldSkip() {
@@ -103,6 +111,16 @@ func (g *ClassGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string
 		}
 	}
 
+	// Factory methods and the exactly-one check of @oneOf inputs
+	if typeDef.IsOneOf() {
+		members, err := g.oneOfGen.GenerateClassMembers(tc, fieldContexts)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(members)
+		sb.WriteString("\n")
+	}
+
 	sb.WriteString("}\n")
 
 	// Build final output with imports
@@ -124,24 +142,25 @@ func (g *ClassGenerator) generateClassAnnotations(tc *TypeContext) []string {
 	var annotations []string
 
 	// Deprecated annotation
-	if deprecated, _ := tc.CustomAnnotation.GenerateDeprecatedAnnotation(tc.TypeDef.Directives); deprecated != "" {
-		annotations = append(annotations, deprecated)
+	if deprecated, deprecatedImport := tc.CustomAnnotation.GenerateDeprecatedAnnotation(tc.TypeDef.Directives); deprecated != "" {
+		annotations = append(annotations, tc.Imports.Resolve(deprecated, []string{deprecatedImport}))
 	}
 
+	// JPA annotations of entities
+	persistenceAnns, persistenceImports := tc.PersistenceGen.GenerateTypeAnnotations(tc.TypeDef)
+	annotations = append(annotations, tc.Imports.ResolveAll(persistenceAnns, persistenceImports)...)
+
 	// Lombok annotations
 	lombokAnns, lombokImports := tc.LombokGen.GenerateTypeAnnotations(tc.TypeDef)
-	annotations = append(annotations, lombokAnns...)
-	tc.Imports.AddAll(lombokImports)
+	annotations = append(annotations, tc.Imports.ResolveAll(lombokAnns, lombokImports)...)
 
 	// Validation annotations
 	validationAnns, validationImports := tc.ValidationGen.GenerateTypeAnnotations(tc.TypeDef)
-	annotations = append(annotations, validationAnns...)
-	tc.Imports.AddAll(validationImports)
+	annotations = append(annotations, tc.Imports.ResolveAll(validationAnns, validationImports)...)
 
 	// Custom annotations
 	customAnns, customImports := tc.CustomAnnotation.GenerateTypeAnnotations(tc.TypeDef)
-	annotations = append(annotations, customAnns...)
-	tc.Imports.AddAll(customImports)
+	annotations = append(annotations, tc.Imports.ResolveAll(customAnns, customImports)...)
 
 	return annotations
 }
@@ -162,6 +162,44 @@ func TestClassGenerator_Generate_WithValidation(t *testing.T) {
 	assert.Contains(t, content, "import jakarta.validation.constraints.NotNull;")
 }
 
+func TestClassGenerator_Generate_WithCascadedValidation(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Output.Package = "com.test"
+	cfg.Features.Validation.Enabled = true
+	cfg.Features.Validation.NotNullOnNonNull = true
+
+	result := generateNamed(t, cfg, `
+input AddressInput { street: String! }
+enum Role { ADMIN }
+input CreateUserInput {
+  address: AddressInput
+  addresses: [AddressInput!]!
+  tags: [String!]
+  roles: [Role]
+  grid: [[AddressInput]]
+}
+type Query { user: String }
+`)
+	require.Empty(t, result.Errors)
+
+	content := fileContents(result)["CreateUserInput.java"]
+	assert.Contains(t, content, "    @Valid\n    private AddressInput address;\n")
+	assert.Contains(t, content, "    @NotNull\n    private List<@Valid @NotNull AddressInput> addresses;\n")
+	assert.Contains(t, content, "    private List<@NotNull String> tags;\n")
+	assert.Contains(t, content, "    private List<Role> roles;\n")
+	assert.Contains(t, content, "    private List<List<@Valid AddressInput>> grid;\n")
+	assert.Contains(t, content, "import jakarta.validation.Valid;")
+
+	// Bean Validation 1.1 cascades lists at the field
+	cfg.Features.Validation.Version = config.ValidationVersion11
+	content = fileContents(generateNamed(t, cfg, `
+input AddressInput { street: String! }
+input CreateUserInput { addresses: [AddressInput!]! }
+type Query { user: String }
+`))["CreateUserInput.java"]
+	assert.Contains(t, content, "    @NotNull\n    @Valid\n    private List<AddressInput> addresses;\n")
+}
+
 func TestClassGenerator_Generate_SkipDirective(t *testing.T) {
 	cfg := config.DefaultConfig()
 	cfg.Output.Package = "com.test"
@@ -0,0 +1,380 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// collisionHint is the hint of naming collision errors.
+const collisionHint = "use @javaName to choose another name, or set java.naming.collisions to suffix"
+
+// objectGetClass is the accessor-like final method every class inherits.
+const objectGetClass = "getClass"
+
+// resolveNames finds schema elements that map to the same Java name: types,
+// including file names that differ only in case, fields and enum constants of
+// a type, and the accessor methods of its fields. Elements named with
+// @javaName keep their name; otherwise the first in schema order does. With
+// the suffix strategy the other elements are renamed by appending a number
+// and a warning is recorded. Otherwise an error is recorded for their type,
+// which is then not generated. The classes generated alongside the types,
+// such as the constants classes, are resolved last and never rename a type.
+func (c *Context) resolveNames() {
+	types := c.namedTypes()
+	c.resolveTypeNames(types)
+
+	for _, t := range types {
+		tc := NewTypeContext(c, t)
+		switch t.Kind {
+		case parser.TypeKindEnum:
+			tc.resolveEnumValueNames()
+		case parser.TypeKindObject, parser.TypeKindInputObject, parser.TypeKindInterface:
+			tc.resolveFieldNames()
+			tc.resolveAccessorNames()
+		}
+	}
+
+	c.resolveConstantsNames(types)
+}
+
+// namedTypes returns the generated schema types, those with a @javaName
+// first, then by name.
+func (c *Context) namedTypes() []*parser.TypeDef {
+	var types []*parser.TypeDef
+	for _, t := range c.Schema.Types {
+		if t.Kind == parser.TypeKindScalar || parser.IsFederationType(t.Name) || NewTypeContext(c, t).ShouldSkip() {
+			continue
+		}
+		types = append(types, t)
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		iExplicit := parser.ExtractJavaNameDirective(types[i].Directives) != nil
+		jExplicit := parser.ExtractJavaNameDirective(types[j].Directives) != nil
+		if iExplicit != jExplicit {
+			return iExplicit
+		}
+		return types[i].Name < types[j].Name
+	})
+	return types
+}
+
+// resolveTypeNames resolves types with the same Java name, or with names
+// that differ only in case, since their files clash on case-insensitive file
+// systems.
+func (c *Context) resolveTypeNames(types []*parser.TypeDef) {
+	initial := make(map[string]bool)
+	for _, t := range types {
+		initial[strings.ToLower(c.NamingHelper.GetTypeName(t))] = true
+	}
+
+	owners := make(map[string]*parser.TypeDef)
+	taken := func(name string) bool {
+		name = strings.ToLower(name)
+		return initial[name] || owners[name] != nil
+	}
+
+	for _, t := range types {
+		name := c.NamingHelper.GetTypeName(t)
+		if other, clash := owners[strings.ToLower(name)]; clash {
+			otherName := c.NamingHelper.GetTypeName(other)
+			message := fmt.Sprintf("types %s and %s both map to Java type %s", other.Name, t.Name, name)
+			if otherName != name {
+				message = fmt.Sprintf("type %s maps to %s.java, which clashes with %s.java of type %s on case-insensitive file systems",
+					t.Name, name, otherName, other.Name)
+			}
+			err := errors.NewGenerateError(message, nil).WithTypeName(t.Name)
+			err.WithLocation(t.Location)
+
+			renamed, ok := c.resolveCollision(err, name, taken)
+			if !ok {
+				continue
+			}
+			c.NamingHelper.typeNames[t.Name] = renamed
+			name = renamed
+		}
+		owners[strings.ToLower(name)] = t
+	}
+}
+
+// resolveConstantsNames resolves the names of the classes holding GraphQL
+// names that collide with generated types: the <Type>Fields classes of the
+// separate style and the class of the root types. As file names, they may
+// not differ from a type name only in case. Classes whose collision is
+// reported as an error are not generated.
+func (c *Context) resolveConstantsNames(types []*parser.TypeDef) {
+	constants := c.Config.Features.Constants
+	if !constants.Enabled {
+		return
+	}
+	c.constantsNames = make(map[string]string)
+
+	owners := make(map[string]bool)
+	for _, t := range types {
+		owners[strings.ToLower(c.NamingHelper.GetTypeName(t))] = true
+	}
+	taken := func(name string) bool { return owners[strings.ToLower(name)] }
+
+	if constants.Style == config.ConstantsSeparate {
+		for _, t := range types {
+			if !hasNameConstants(t) || len(c.nameErrors[t.Name]) > 0 {
+				continue
+			}
+			name := c.NamingHelper.GetTypeName(t) + "Fields"
+			if taken(name) {
+				err := errors.NewGenerateError(
+					fmt.Sprintf("constants class %s of type %s collides with a generated type", name, t.Name),
+					nil,
+				).WithTypeName(t.Name)
+				err.WithLocation(t.Location)
+
+				renamed, ok := c.resolveClassCollision(err, name, taken,
+					"use @javaName to choose another name for "+t.Name+", or set java.naming.collisions to suffix")
+				if !ok {
+					continue
+				}
+				name = renamed
+			}
+			owners[strings.ToLower(name)] = true
+			c.constantsNames[t.Name] = name
+		}
+	}
+
+	name := constants.ClassName
+	if name == "" {
+		name = config.DefaultConfig().Features.Constants.ClassName
+	}
+	if taken(name) {
+		err := errors.NewGenerateError(fmt.Sprintf("constants class %s collides with a generated type", name), nil)
+		renamed, ok := c.resolveClassCollision(err, name, taken,
+			"set features.constants.className to another name, or set java.naming.collisions to suffix")
+		if !ok {
+			return
+		}
+		name = renamed
+	}
+	c.rootConstantsName = name
+}
+
+// resolveEnumValueNames resolves enum values with the same constant name.
+func (tc *TypeContext) resolveEnumValueNames() {
+	var values []*parser.EnumValueDef
+	for _, ev := range tc.TypeDef.EnumValues {
+		if !tc.ShouldSkipEnumValue(ev) {
+			values = append(values, ev)
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return parser.ExtractJavaNameDirective(values[i].Directives) != nil &&
+			parser.ExtractJavaNameDirective(values[j].Directives) == nil
+	})
+
+	initial := make(map[string]bool)
+	for _, ev := range values {
+		initial[tc.NamingHelper.GetEnumValueName(ev)] = true
+	}
+
+	owners := make(map[string]*parser.EnumValueDef)
+	taken := func(name string) bool { return initial[name] || owners[name] != nil }
+
+	for _, ev := range values {
+		name := tc.NamingHelper.GetEnumValueName(ev)
+		if other, clash := owners[name]; clash {
+			err := errors.NewGenerateError(
+				fmt.Sprintf("enum values %s.%s and %s.%s both map to Java constant %s",
+					tc.TypeDef.Name, other.Name, tc.TypeDef.Name, ev.Name, name),
+				nil,
+			).WithTypeName(tc.TypeDef.Name)
+			err.WithLocation(ev.Location)
+
+			renamed, ok := tc.resolveCollision(err, name, taken)
+			if !ok {
+				continue
+			}
+			tc.NamingHelper.enumValueNames[ev] = renamed
+			name = renamed
+		}
+		owners[name] = ev
+	}
+}
+
+// resolveFieldNames resolves fields with the same Java field name, e.g.
+// user_name and userName.
+func (tc *TypeContext) resolveFieldNames() {
+	fields := tc.namedFields()
+
+	initial := make(map[string]bool)
+	for _, f := range fields {
+		initial[tc.javaFieldName(f)] = true
+	}
+
+	owners := make(map[string]*parser.FieldDef)
+	taken := func(name string) bool { return initial[name] || owners[name] != nil }
+
+	for _, f := range fields {
+		name := tc.javaFieldName(f)
+		if other, clash := owners[name]; clash {
+			err := errors.NewGenerateError(
+				fmt.Sprintf("fields %s.%s and %s.%s both map to Java field %s",
+					tc.TypeDef.Name, other.Name, tc.TypeDef.Name, f.Name, name),
+				nil,
+			).WithTypeName(tc.TypeDef.Name).WithFieldName(f.Name)
+			err.WithLocation(f.Location)
+
+			renamed, ok := tc.resolveCollision(err, name, taken)
+			if !ok {
+				continue
+			}
+			tc.NamingHelper.fieldNames[f] = renamed
+			name = renamed
+		}
+		owners[name] = f
+	}
+}
+
+// resolveAccessorNames resolves fields with distinct Java names whose
+// getters or setters have the same name, e.g. the Lombok accessors of a
+// boolean isActive and a String active field are isActive/setActive and
+// getActive/setActive.
+func (tc *TypeContext) resolveAccessorNames() {
+	fields := tc.namedFields()
+
+	fieldNames := make(map[string]bool)
+	for _, f := range fields {
+		fieldNames[tc.javaFieldName(f)] = true
+	}
+
+	owners := make(map[string]*parser.FieldDef)
+	taken := func(name string) bool {
+		if fieldNames[name] {
+			return true
+		}
+		for _, method := range tc.accessorNames(nil, name) {
+			if owners[method] != nil || method == objectGetClass {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, f := range fields {
+		name := tc.javaFieldName(f)
+		for _, method := range tc.accessorNames(f, name) {
+			var message string
+			if other, clash := owners[method]; clash && tc.javaFieldName(other) != name {
+				// Fields with the same name are reported by resolveFieldNames
+				message = fmt.Sprintf("fields %s.%s and %s.%s both have an accessor named %s",
+					tc.TypeDef.Name, other.Name, tc.TypeDef.Name, f.Name, method)
+			} else if method == objectGetClass {
+				message = fmt.Sprintf("field %s.%s has an accessor named %s, which clashes with Object.%s",
+					tc.TypeDef.Name, f.Name, method, method)
+			} else {
+				continue
+			}
+
+			err := errors.NewGenerateError(message, nil).WithTypeName(tc.TypeDef.Name).WithFieldName(f.Name)
+			err.WithLocation(f.Location)
+
+			if renamed, ok := tc.resolveCollision(err, name, taken); ok {
+				tc.NamingHelper.fieldNames[f] = renamed
+				fieldNames[renamed] = true
+				name = renamed
+			}
+			break
+		}
+		for _, method := range tc.accessorNames(f, name) {
+			if owners[method] == nil {
+				owners[method] = f
+			}
+		}
+	}
+}
+
+// namedFields returns the generated fields of the type, those with a
+// @javaName first.
+func (tc *TypeContext) namedFields() []*parser.FieldDef {
+	var fields []*parser.FieldDef
+	for _, f := range tc.TypeDef.Fields {
+		fc := &FieldContext{TypeContext: tc, Field: f}
+		if !fc.ShouldSkip() {
+			fields = append(fields, f)
+		}
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return parser.ExtractJavaNameDirective(fields[i].Directives) != nil &&
+			parser.ExtractJavaNameDirective(fields[j].Directives) == nil
+	})
+	return fields
+}
+
+// javaFieldName returns the Java field name of a field as used in the
+// generated code. Java keywords get an underscore prefix, while Kotlin
+// keywords are written in backticks and keep their name.
+func (tc *TypeContext) javaFieldName(field *parser.FieldDef) string {
+	if tc.Config.Target == config.TargetKotlin {
+		return tc.NamingHelper.GetFieldName(field)
+	}
+	return EscapeJavaKeyword(tc.NamingHelper.GetFieldName(field))
+}
+
+// accessorNames returns the names of the accessor methods of a field with the
+// given Java name: the getter of an interface method, the getter and setter
+// of a class, named as Lombok does if Lombok generates them. A nil field is
+// treated as not boolean.
+func (tc *TypeContext) accessorNames(field *parser.FieldDef, name string) []string {
+	javaType := ""
+	if field != nil {
+		if result, err := tc.TypeMapper.MapFieldType(field); err == nil {
+			javaType = result.JavaType
+		}
+	}
+	isBoolean := javaType == "boolean" || javaType == "Boolean"
+
+	if tc.TypeDef.Kind == parser.TypeKindInterface {
+		return []string{tc.NamingHelper.GetGetterName(name, isBoolean)}
+	}
+	if !tc.LombokGen.NeedsGettersSetters() {
+		getter, setter := tc.NamingHelper.lombokAccessorNames(name, javaType == "boolean")
+		return []string{getter, setter}
+	}
+	return []string{tc.NamingHelper.GetGetterName(name, isBoolean), tc.NamingHelper.GetSetterName(name)}
+}
+
+// resolveCollision handles a naming collision reported by err. With the
+// suffix strategy it returns name with the lowest number appended that is
+// not taken, and records err as a warning. Otherwise it records err as an
+// error of its type and returns false.
+func (c *Context) resolveCollision(err *errors.GenerateError, name string, taken func(string) bool) (string, bool) {
+	if c.Config.Java.Naming.Collisions != config.CollisionsSuffix {
+		err.WithHint(collisionHint)
+		c.nameErrors[err.TypeName] = append(c.nameErrors[err.TypeName], err)
+		return "", false
+	}
+
+	renamed := name
+	for i := 2; taken(renamed); i++ {
+		renamed = name + strconv.Itoa(i)
+	}
+	err.Message += ", renamed to " + renamed
+	c.Warn(err)
+	return renamed, true
+}
+
+// resolveClassCollision handles a collision of a class generated alongside
+// the types, reported by err, like resolveCollision. Errors are recorded for
+// the class only, with the given hint, so that the types are still
+// generated.
+func (c *Context) resolveClassCollision(err *errors.GenerateError, name string, taken func(string) bool, hint string) (string, bool) {
+	if c.Config.Java.Naming.Collisions == config.CollisionsSuffix {
+		return c.resolveCollision(err, name, taken)
+	}
+	err.WithHint(hint)
+	c.classNameErrors = append(c.classNameErrors, err)
+	return "", false
+}
@@ -0,0 +1,230 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+const collidingTypesSchema = `
+directive @javaName(name: String!) on OBJECT | FIELD_DEFINITION | ENUM_VALUE
+type Query { account: Account, user: User, legacy: user }
+type Account @javaName(name: "User") { id: ID }
+type User { id: ID }
+type user { id: ID }
+`
+
+func generateNamed(t *testing.T, cfg *config.Config, sdl string) *Result {
+	schema, err := parser.NewParser().Parse(sdl, "test.graphql")
+	require.NoError(t, err)
+	return NewGenerator(cfg).GenerateWithResult(schema)
+}
+
+func errorMessages(errs []error) []string {
+	var messages []string
+	for _, err := range errs {
+		for _, d := range errors.Diagnostics(err) {
+			messages = append(messages, d.Message)
+		}
+	}
+	return messages
+}
+
+func fileContents(result *Result) map[string]string {
+	contents := make(map[string]string)
+	for _, f := range result.Files {
+		contents[f.FileName] = f.Content
+	}
+	return contents
+}
+
+func TestCollisions_TypesError(t *testing.T) {
+	result := generateNamed(t, config.DefaultConfig(), collidingTypesSchema)
+
+	assert.ElementsMatch(t, []string{
+		"types Account and User both map to Java type User",
+		"type user maps to user.java, which clashes with User.java of type Account on case-insensitive file systems",
+	}, errorMessages(result.Errors))
+
+	d := errors.Diagnostics(result.Errors[0])[0]
+	assert.Equal(t, collisionHint, d.Hint)
+	assert.Equal(t, "test.graphql", d.File)
+
+	// Only the type keeping the name is generated
+	contents := fileContents(result)
+	assert.Contains(t, contents["User.java"], "public class User {")
+	assert.NotContains(t, contents, "user.java")
+}
+
+func TestCollisions_TypesSuffix(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.Naming.Collisions = config.CollisionsSuffix
+
+	result := generateNamed(t, cfg, collidingTypesSchema)
+	require.Empty(t, result.Errors)
+
+	assert.Equal(t, []string{
+		"types Account and User both map to Java type User, renamed to User2",
+		"type user maps to user.java, which clashes with User.java of type Account on case-insensitive file systems, renamed to user3",
+	}, warningMessages(result.Warnings))
+
+	contents := fileContents(result)
+	assert.Contains(t, contents["User.java"], "public class User {")
+	assert.Contains(t, contents["User2.java"], "public class User2 {")
+	assert.Contains(t, contents["user3.java"], "public class user3 {")
+
+	// References use the new names
+	assert.Contains(t, contents["Query.java"], "private User account;")
+	assert.Contains(t, contents["Query.java"], "private User2 user;")
+	assert.Contains(t, contents["Query.java"], "private user3 legacy;")
+}
+
+func TestCollisions_Fields(t *testing.T) {
+	sdl := `
+type User {
+  userName: String
+  user_name: String
+  name: String
+  Name: String
+  _class: String
+}
+`
+	result := generateNamed(t, config.DefaultConfig(), sdl)
+	assert.Equal(t, []string{
+		"fields User.userName and User.user_name both map to Java field userName",
+		"fields User.name and User.Name both map to Java field name",
+		"field User._class has an accessor named getClass, which clashes with Object.getClass",
+	}, errorMessages(result.Errors))
+	assert.Empty(t, result.Files)
+
+	cfg := config.DefaultConfig()
+	cfg.Java.Naming.Collisions = config.CollisionsSuffix
+	result = generateNamed(t, cfg, sdl)
+	require.Empty(t, result.Errors)
+
+	content := result.Files[0].Content
+	assert.Contains(t, content, "private String userName;")
+	assert.Contains(t, content, "private String userName2;")
+	assert.Contains(t, content, "private String name2;")
+	assert.Contains(t, content, "private String Class2;")
+	assert.Contains(t, content, "public String getUserName2()")
+}
+
+func TestCollisions_JavaNameKeepsName(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.Naming.Collisions = config.CollisionsSuffix
+
+	result := generateNamed(t, cfg, `
+directive @javaName(name: String!) on FIELD_DEFINITION | ENUM_VALUE
+type User {
+  name: String
+  fullName: String @javaName(name: "name")
+}
+enum Role { ADMIN, ADMINISTRATOR @javaName(name: "ADMIN") }
+`)
+	require.Empty(t, result.Errors)
+
+	assert.ElementsMatch(t, []string{
+		"fields User.fullName and User.name both map to Java field name, renamed to name2",
+		"enum values Role.ADMINISTRATOR and Role.ADMIN both map to Java constant ADMIN, renamed to ADMIN2",
+	}, warningMessages(result.Warnings))
+
+	contents := fileContents(result)
+	assert.Contains(t, contents["Role.java"], "ADMIN2")
+	assert.Contains(t, contents["User.java"], "private String name2;")
+}
+
+func TestCollisions_LombokAccessors(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Lombok.Enabled = true
+	cfg.Features.Lombok.Data = true
+
+	sdl := `
+type User {
+  isActive: Boolean!
+  active: String
+}
+`
+	result := generateNamed(t, cfg, sdl)
+	assert.Equal(t, []string{
+		"fields User.isActive and User.active both have an accessor named setActive",
+	}, errorMessages(result.Errors))
+
+	cfg.Java.Naming.Collisions = config.CollisionsSuffix
+	result = generateNamed(t, cfg, sdl)
+	require.Empty(t, result.Errors)
+	assert.Contains(t, result.Files[0].Content, "private String active2;")
+}
+
+func TestCollisions_NoCollisions(t *testing.T) {
+	result := generateNamed(t, config.DefaultConfig(), `
+type User { id: ID, name: String, isActive: Boolean, active: Boolean }
+enum Role { ADMIN, USER }
+`)
+	assert.Empty(t, result.Errors)
+	assert.Empty(t, result.Warnings)
+}
+
+func TestNamingHelper_LombokAccessorNames(t *testing.T) {
+	n := NewNamingHelper(&config.NamingConfig{})
+
+	tests := []struct {
+		field            string
+		primitiveBoolean bool
+		getter, setter   string
+	}{
+		{"name", false, "getName", "setName"},
+		{"active", true, "isActive", "setActive"},
+		{"isActive", true, "isActive", "setActive"},
+		{"isActive", false, "getIsActive", "setIsActive"},
+		{"island", true, "isIsland", "setIsland"},
+	}
+
+	for _, tt := range tests {
+		getter, setter := n.lombokAccessorNames(tt.field, tt.primitiveBoolean)
+		assert.Equal(t, tt.getter, getter, tt.field)
+		assert.Equal(t, tt.setter, setter, tt.field)
+	}
+}
+
+func TestShadowedTypes_QualifiedReferences(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TypeMappings.Scalars = map[string]config.ScalarMapping{
+		"Timestamp": {JavaType: "Date", Imports: []string{"java.util.Date"}},
+		"JSON":      {JavaType: "Map<String, Object>", Imports: []string{"java.util.Map"}},
+	}
+
+	result := generateNamed(t, cfg, `
+scalar Timestamp
+scalar JSON
+type Query { event: Event }
+type Date { day: Int }
+type Object { id: ID }
+type Deprecated { id: ID }
+type Event {
+  at: Timestamp!
+  history: [Timestamp]
+  day: Date
+  payload: JSON
+  owner: Object
+  name: String @deprecated
+}
+`)
+	require.Empty(t, result.Errors)
+
+	event := fileContents(result)["Event.java"]
+	assert.NotContains(t, event, "import java.util.Date;")
+	assert.Contains(t, event, "import java.util.Map;")
+	assert.Contains(t, event, "private java.util.Date at;")
+	assert.Contains(t, event, "private List<java.util.Date> history;")
+	assert.Contains(t, event, "private Date day;")
+	assert.Contains(t, event, "private Map<String, java.lang.Object> payload;")
+	assert.Contains(t, event, "private Object owner;")
+	assert.Contains(t, event, "@java.lang.Deprecated\n    private String name;")
+	assert.Contains(t, event, "public java.util.Date getAt()")
+}
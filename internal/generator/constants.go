@@ -0,0 +1,259 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// TypeNameConstant is the name of the constant holding the GraphQL name of a
+// type.
+const TypeNameConstant = "TYPE_NAME"
+
+// nameConstant is a constant holding a GraphQL name.
+type nameConstant struct {
+	Name  string
+	Value string
+}
+
+// nameConstants returns the constants of GraphQL names, in order. Constant
+// names are the names in UPPER_SNAKE_CASE, made unique and distinct from the
+// reserved names by a number: ID and ID_2 for id and _id.
+func nameConstants(names []string, reserved ...string) []nameConstant {
+	used := make(map[string]bool)
+	for _, name := range reserved {
+		used[name] = true
+	}
+
+	constants := make([]nameConstant, 0, len(names))
+	for _, name := range names {
+		base := defaultAcronymPolicy.upperSnakeCase(name)
+		if base == "" {
+			base = "FIELD"
+		}
+		constant := base
+		for n := 2; used[constant]; n++ {
+			constant = base + "_" + strconv.Itoa(n)
+		}
+		used[constant] = true
+		constants = append(constants, nameConstant{Name: constant, Value: name})
+	}
+	return constants
+}
+
+// constantFieldNames returns the GraphQL names of the fields of a type that
+// get constants: all fields but the introspection fields and those
+// federation adds to the query type.
+func constantFieldNames(schema *parser.Schema, typeDef *parser.TypeDef) []string {
+	var names []string
+	for _, field := range typeDef.Fields {
+		if strings.HasPrefix(field.Name, "__") ||
+			parser.IsFederationQueryField(field.Name) && schema.IsRootType(typeDef.Name) {
+			continue
+		}
+		names = append(names, field.Name)
+	}
+	return names
+}
+
+// hasNameConstants returns true if constants of GraphQL names are generated
+// for the type: objects, inputs and interfaces.
+func hasNameConstants(typeDef *parser.TypeDef) bool {
+	switch typeDef.Kind {
+	case parser.TypeKindObject, parser.TypeKindInputObject, parser.TypeKindInterface:
+		return true
+	}
+	return false
+}
+
+// writeConstant writes a String constant. Members of interfaces are
+// implicitly public, static and final.
+func writeConstant(sb *strings.Builder, indent string, constant nameConstant, inInterface bool) {
+	sb.WriteString(indent)
+	if !inInterface {
+		sb.WriteString("public static final ")
+	}
+	sb.WriteString("String ")
+	sb.WriteString(constant.Name)
+	sb.WriteString(" = ")
+	sb.WriteString(strconv.Quote(constant.Value))
+	sb.WriteString(";\n")
+}
+
+// generateNestedConstants generates the members holding the GraphQL names of
+// a type in the nested style: a TYPE_NAME constant and a Fields class with a
+// constant per field. It returns "" unless the nested style is enabled.
+func generateNestedConstants(tc *TypeContext, inInterface bool) string {
+	constants := tc.Config.Features.Constants
+	if !constants.Enabled || constants.Style == config.ConstantsSeparate || !hasNameConstants(tc.TypeDef) {
+		return ""
+	}
+
+	var sb strings.Builder
+	writeConstant(&sb, "    ", nameConstant{Name: TypeNameConstant, Value: tc.TypeDef.Name}, inInterface)
+	sb.WriteString("\n")
+
+	fields := nameConstants(constantFieldNames(tc.Schema, tc.TypeDef))
+	if len(fields) == 0 {
+		return sb.String()
+	}
+
+	// A nested Fields class would shadow a generated Fields type
+	className := "Fields"
+	for _, name := range tc.javaTypeNames {
+		if name == className {
+			className = "FieldNames"
+		}
+	}
+
+	sb.WriteString("    /**\n")
+	sb.WriteString("     * GraphQL names of the fields of " + tc.TypeDef.Name + ".\n")
+	sb.WriteString("     */\n")
+	if inInterface {
+		sb.WriteString("    final class " + className + " {\n")
+	} else {
+		sb.WriteString("    public static final class " + className + " {\n")
+	}
+	for _, field := range fields {
+		writeConstant(&sb, "        ", field, false)
+	}
+	sb.WriteString("\n")
+	sb.WriteString("        private " + className + "() {\n")
+	sb.WriteString("        }\n")
+	sb.WriteString("    }\n\n")
+
+	return sb.String()
+}
+
+// generateConstantsFiles generates the classes holding GraphQL names, if
+// enabled in the configuration: a <Type>Fields class per type in the
+// separate style, and the class with the constants of the root types.
+// Classes colliding with a generated type are renamed or reported, as
+// resolved by the collisions pass.
+func (g *Generator) generateConstantsFiles(ctx *Context) ([]*GeneratedFile, []error) {
+	constants := ctx.Config.Features.Constants
+	if !constants.Enabled {
+		return nil, nil
+	}
+
+	var files []*GeneratedFile
+
+	if constants.Style == config.ConstantsSeparate {
+		for _, typeDef := range ctx.namedTypes() {
+			className, ok := ctx.constantsNames[typeDef.Name]
+			if !ok || len(ctx.nameErrors[typeDef.Name]) > 0 {
+				continue
+			}
+			files = append(files, g.generateFieldsClass(ctx, typeDef, className))
+		}
+	}
+
+	if ctx.rootConstantsName != "" {
+		if file := g.generateRootConstants(ctx, ctx.rootConstantsName); file != nil {
+			files = append(files, file)
+		}
+	}
+
+	return files, ctx.classNameErrors
+}
+
+// generateFieldsClass generates the <Type>Fields class holding the GraphQL
+// names of a type and its fields.
+func (g *Generator) generateFieldsClass(ctx *Context, typeDef *parser.TypeDef, className string) *GeneratedFile {
+
+	var sb strings.Builder
+	writeConstant(&sb, "    ", nameConstant{Name: TypeNameConstant, Value: typeDef.Name}, false)
+	if fields := nameConstants(constantFieldNames(ctx.Schema, typeDef), TypeNameConstant); len(fields) > 0 {
+		sb.WriteString("\n")
+		for _, field := range fields {
+			writeConstant(&sb, "    ", field, false)
+		}
+	}
+
+	return constantsFile(ctx, className, "GraphQL names of the "+typeDef.Name+" type and its fields.", sb.String())
+}
+
+// generateRootConstants generates the class holding the GraphQL names of the
+// root types, their fields and the arguments of their fields, or nil if the
+// schema has no root types.
+func (g *Generator) generateRootConstants(ctx *Context, className string) *GeneratedFile {
+	roots := []struct {
+		operation string
+		name      string
+	}{
+		{"QUERY", ctx.Schema.QueryType},
+		{"MUTATION", ctx.Schema.MutationType},
+		{"SUBSCRIPTION", ctx.Schema.SubscriptionType},
+	}
+
+	var header, body strings.Builder
+	for _, root := range roots {
+		typeDef := ctx.Schema.GetType(root.name)
+		if typeDef == nil {
+			continue
+		}
+		writeConstant(&header, "    ", nameConstant{Name: root.operation + "_TYPE", Value: typeDef.Name}, false)
+
+		className := defaultAcronymPolicy.upperSnakeCase(typeDef.Name)
+		body.WriteString("    public static final class " + className + " {\n")
+		writeConstant(&body, "        ", nameConstant{Name: TypeNameConstant, Value: typeDef.Name}, false)
+
+		fields := nameConstants(constantFieldNames(ctx.Schema, typeDef), TypeNameConstant)
+		if len(fields) > 0 {
+			body.WriteString("\n")
+		}
+		for _, field := range fields {
+			writeConstant(&body, "        ", field, false)
+		}
+
+		for _, field := range fields {
+			fieldDef := typeDef.GetField(field.Value)
+			if len(fieldDef.Arguments) == 0 {
+				continue
+			}
+			argumentNames := make([]string, len(fieldDef.Arguments))
+			for i, arg := range fieldDef.Arguments {
+				argumentNames[i] = arg.Name
+			}
+
+			body.WriteString("\n")
+			body.WriteString("        public static final class " + field.Name + "_INPUT_ARGUMENT {\n")
+			for _, arg := range nameConstants(argumentNames) {
+				writeConstant(&body, "            ", arg, false)
+			}
+			body.WriteString("        }\n")
+		}
+		body.WriteString("    }\n\n")
+	}
+	if body.Len() == 0 {
+		return nil
+	}
+
+	return constantsFile(ctx, className, "GraphQL names of the root types, their fields and arguments.",
+		header.String()+"\n"+strings.TrimSuffix(body.String(), "\n"))
+}
+
+// constantsFile creates the file of a final class holding constants. It
+// declares no schema type, so its TypeDef is nil.
+func constantsFile(ctx *Context, className, description, members string) *GeneratedFile {
+	var sb strings.Builder
+	sb.WriteString("package ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n\n")
+	sb.WriteString("/**\n")
+	sb.WriteString(" * " + description + "\n")
+	sb.WriteString(" */\n")
+	sb.WriteString("public final class " + className + " {\n\n")
+	sb.WriteString(members)
+	sb.WriteString("\n")
+	sb.WriteString("    private " + className + "() {\n")
+	sb.WriteString("    }\n")
+	sb.WriteString("}\n")
+
+	return &GeneratedFile{
+		FileName: className + ".java",
+		Content:  sb.String(),
+	}
+}
@@ -0,0 +1,211 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+const constantsSchema = `
+type Query {
+  user(id: ID!, includeDeleted: Boolean): User
+  users: [User]
+}
+type Mutation { createUser(input: UserInput!): User }
+interface Node { id: ID! }
+type User implements Node { id: ID!, firstName: String }
+input UserInput { firstName: String }
+`
+
+func TestNameConstants(t *testing.T) {
+	constants := nameConstants([]string{"id", "_id", "firstName", "typeName", "userID"}, TypeNameConstant)
+
+	assert.Equal(t, []nameConstant{
+		{Name: "ID", Value: "id"},
+		{Name: "ID_2", Value: "_id"},
+		{Name: "FIRST_NAME", Value: "firstName"},
+		{Name: "TYPE_NAME_2", Value: "typeName"},
+		{Name: "USER_ID", Value: "userID"},
+	}, constants)
+}
+
+func TestConstants_Nested(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Constants.Enabled = true
+
+	result := generateNamed(t, cfg, constantsSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.Contains(t, files["User.java"], `public class User implements Node {
+
+    public static final String TYPE_NAME = "User";
+
+    /**
+     * GraphQL names of the fields of User.
+     */
+    public static final class Fields {
+        public static final String ID = "id";
+        public static final String FIRST_NAME = "firstName";
+
+        private Fields() {
+        }
+    }
+
+    private String id;
+`)
+	assert.Contains(t, files["Node.java"], `public interface Node {
+
+    String TYPE_NAME = "Node";
+
+    /**
+     * GraphQL names of the fields of Node.
+     */
+    final class Fields {
+        public static final String ID = "id";
+`)
+	assert.Contains(t, files["UserInput.java"], "    public static final class Fields {\n")
+	assert.NotContains(t, files, "UserFields.java")
+
+	assert.Equal(t, `package com.example.model;
+
+/**
+ * GraphQL names of the root types, their fields and arguments.
+ */
+public final class GraphQLConstants {
+
+    public static final String QUERY_TYPE = "Query";
+    public static final String MUTATION_TYPE = "Mutation";
+
+    public static final class QUERY {
+        public static final String TYPE_NAME = "Query";
+
+        public static final String USER = "user";
+        public static final String USERS = "users";
+
+        public static final class USER_INPUT_ARGUMENT {
+            public static final String ID = "id";
+            public static final String INCLUDE_DELETED = "includeDeleted";
+        }
+    }
+
+    public static final class MUTATION {
+        public static final String TYPE_NAME = "Mutation";
+
+        public static final String CREATE_USER = "createUser";
+
+        public static final class CREATE_USER_INPUT_ARGUMENT {
+            public static final String INPUT = "input";
+        }
+    }
+
+    private GraphQLConstants() {
+    }
+}
+`, files["GraphQLConstants.java"])
+}
+
+func TestConstants_Separate(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Constants = config.ConstantsConfig{
+		Enabled:   true,
+		Style:     config.ConstantsSeparate,
+		ClassName: "DgsConstants",
+	}
+
+	result := generateNamed(t, cfg, constantsSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.Equal(t, `package com.example.model;
+
+/**
+ * GraphQL names of the User type and its fields.
+ */
+public final class UserFields {
+
+    public static final String TYPE_NAME = "User";
+
+    public static final String ID = "id";
+    public static final String FIRST_NAME = "firstName";
+
+    private UserFields() {
+    }
+}
+`, files["UserFields.java"])
+	assert.Contains(t, files, "NodeFields.java")
+	assert.Contains(t, files, "UserInputFields.java")
+	assert.Contains(t, files, "DgsConstants.java")
+	assert.NotContains(t, files["User.java"], "TYPE_NAME")
+}
+
+func TestConstants_FieldsTypeNotShadowed(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Constants.Enabled = true
+
+	result := generateNamed(t, cfg, `
+type Fields { name: String }
+type Form { fields: Fields }
+`)
+	require.Empty(t, result.Errors)
+	assert.Contains(t, fileContents(result)["Form.java"], "    public static final class FieldNames {\n")
+}
+
+func TestConstants_Collisions(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Constants = config.ConstantsConfig{
+		Enabled:   true,
+		Style:     config.ConstantsSeparate,
+		ClassName: "GraphQLConstants",
+	}
+	sdl := `
+type Query { user: User }
+type User { id: ID! }
+type UserFields { count: Int }
+type GraphQLConstants { version: String }
+`
+
+	result := generateNamed(t, cfg, sdl)
+	assert.ElementsMatch(t, []string{
+		"constants class UserFields of type User collides with a generated type",
+		"constants class GraphQLConstants collides with a generated type",
+	}, errorMessages(result.Errors))
+	files := fileContents(result)
+	assert.Contains(t, files["UserFields.java"], "public class UserFields {")
+	assert.Contains(t, files["GraphQLConstants.java"], "public class GraphQLConstants {")
+	assert.Contains(t, files, "User.java")
+	assert.Contains(t, files, "QueryFields.java")
+
+	cfg.Java.Naming.Collisions = config.CollisionsSuffix
+	result = generateNamed(t, cfg, sdl)
+	require.Empty(t, result.Errors)
+	assert.ElementsMatch(t, []string{
+		"constants class UserFields of type User collides with a generated type, renamed to UserFields2",
+		"constants class GraphQLConstants collides with a generated type, renamed to GraphQLConstants2",
+	}, warningMessages(result.Warnings))
+	files = fileContents(result)
+	assert.Contains(t, files["UserFields.java"], "public class UserFields {")
+	assert.Contains(t, files["UserFields2.java"], "public final class UserFields2 {")
+	assert.Contains(t, files["UserFields2.java"], `TYPE_NAME = "User";`)
+	assert.Contains(t, files["GraphQLConstants2.java"], "public final class GraphQLConstants2 {")
+}
+
+func TestConstants_NotCountedAsTypes(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Constants = config.ConstantsConfig{Enabled: true, Style: config.ConstantsSeparate}
+
+	result := generateNamed(t, cfg, constantsSchema)
+	require.Empty(t, result.Errors)
+
+	var types int
+	for _, file := range result.Files {
+		if file.TypeDef != nil {
+			types++
+		}
+	}
+	assert.Less(t, types, len(result.Files))
+	assert.Equal(t, types, GetStats(result.Files, nil).TotalTypes)
+}
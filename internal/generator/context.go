@@ -15,7 +15,24 @@ type Context struct {
 	NamingHelper     *NamingHelper
 	LombokGen        *annotations.LombokGenerator
 	ValidationGen    *annotations.ValidationGenerator
+	JacksonGen       *annotations.JacksonGenerator
+	NullabilityGen   *annotations.NullabilityGenerator
+	PersistenceGen   *annotations.PersistenceGenerator
 	CustomAnnotation *annotations.CustomAnnotationGenerator
+
+	warnings      []error
+	warningKeys   map[string]bool
+	nameErrors    map[string][]error
+	javaTypeNames []string
+
+	// classNameErrors are the collisions of classes generated alongside
+	// the types, which are not generated.
+	classNameErrors []error
+	// constantsNames maps types to the name of their <Type>Fields class.
+	constantsNames map[string]string
+	// rootConstantsName is the name of the class with the constants of the
+	// root types, "" if it collides.
+	rootConstantsName string
 }
 
 // NewContext creates a new generation context.
@@ -23,15 +40,51 @@ func NewContext(cfg *config.Config, schema *parser.Schema) *Context {
 	typeMapper := typemap.NewTypeMapper(cfg)
 	typeMapper.SetSchemaTypes(schema.Types)
 
-	return &Context{
+	ctx := &Context{
 		Config:           cfg,
 		Schema:           schema,
 		TypeMapper:       typeMapper,
 		NamingHelper:     NewNamingHelper(&cfg.Java.Naming),
 		LombokGen:        annotations.NewLombokGenerator(&cfg.Features.Lombok),
 		ValidationGen:    annotations.NewValidationGenerator(&cfg.Features.Validation),
-		CustomAnnotation: annotations.NewCustomAnnotationGenerator(),
+		JacksonGen:       annotations.NewJacksonGenerator(&cfg.Features.Jackson),
+		NullabilityGen:   annotations.NewNullabilityGenerator(&cfg.Java),
+		PersistenceGen:   annotations.NewPersistenceGenerator(&cfg.Features.JPA, &cfg.Features.Validation),
+		CustomAnnotation: annotations.NewCustomAnnotationGenerator(cfg.DirectiveAnnotations),
+		warningKeys:      make(map[string]bool),
+		nameErrors:       make(map[string][]error),
+	}
+
+	ctx.CustomAnnotation.SetDirectiveDefinitions(schema.Directives)
+
+	// Types referenced by fields use the names chosen for collisions
+	typeMapper.SetTypeNames(ctx.NamingHelper.typeNames)
+	ctx.resolveNames()
+
+	for _, t := range ctx.namedTypes() {
+		ctx.javaTypeNames = append(ctx.javaTypeNames, ctx.NamingHelper.GetTypeName(t))
 	}
+
+	return ctx
+}
+
+// importManager creates an import manager for a file of the output package,
+// in which the generated types shadow imported and java.lang types.
+func (c *Context) importManager() *ImportManager {
+	imports := NewImportManager(c.Config.Output.Package)
+	if c.Config.Target == config.TargetKotlin {
+		imports = NewKotlinImportManager(c.Config.Output.Package)
+	}
+	imports.Reserve(c.javaTypeNames...)
+	return imports
+}
+
+// isEntityType returns true if the named schema type is generated as a JPA
+// entity.
+func (c *Context) isEntityType(name string) bool {
+	typeDef := c.Schema.GetType(name)
+	return typeDef != nil && c.PersistenceGen.IsEntity(typeDef) &&
+		len(c.nameErrors[name]) == 0 && !NewTypeContext(c, typeDef).ShouldSkip()
 }
 
 // TypeContext holds context for generating a specific type.
@@ -49,13 +102,28 @@ func NewTypeContext(ctx *Context, typeDef *parser.TypeDef) *TypeContext {
 		Context:  ctx,
 		TypeDef:  typeDef,
 		TypeName: typeName,
-		Imports:  NewImportManager(ctx.Config.Output.Package),
+		Imports:  ctx.importManager(),
 	}
 }
 
 // ShouldSkip returns true if the type should be skipped.
 func (tc *TypeContext) ShouldSkip() bool {
-	return parser.ExtractSkipDirective(tc.TypeDef.Directives) != nil
+	if parser.ExtractSkipDirective(tc.TypeDef.Directives) != nil {
+		return true
+	}
+
+	return tc.Config.Features.Federation.SkipInaccessible &&
+		tc.TypeDef.HasDirective(parser.DirectiveInaccessible)
+}
+
+// ShouldSkipEnumValue returns true if the enum value should be skipped.
+func (tc *TypeContext) ShouldSkipEnumValue(ev *parser.EnumValueDef) bool {
+	if parser.ExtractSkipDirective(ev.Directives) != nil {
+		return true
+	}
+
+	return tc.Config.Features.Federation.SkipInaccessible &&
+		ev.HasDirective(parser.DirectiveInaccessible)
 }
 
 // GetVisibility returns the field visibility keyword.
@@ -82,26 +150,45 @@ type FieldContext struct {
 	JavaType  string
 	Imports   []string
 	IsNonNull bool
+	// AnnotatedType is JavaType with the annotations of collection
+	// elements, used in the declarations of the field and its accessors.
+	AnnotatedType     string
+	AnnotationImports []string
 }
 
 // NewFieldContext creates a field-specific context.
 func NewFieldContext(tc *TypeContext, field *parser.FieldDef) (*FieldContext, error) {
-	fieldName := tc.NamingHelper.GetFieldName(field)
-	fieldName = EscapeJavaKeyword(fieldName)
+	fieldName := tc.javaFieldName(field)
 
 	mapResult, err := tc.TypeMapper.MapFieldType(field)
 	if err != nil {
 		return nil, err
 	}
 
-	return &FieldContext{
+	fc := &FieldContext{
 		TypeContext: tc,
 		Field:       field,
 		FieldName:   fieldName,
 		JavaType:    mapResult.JavaType,
 		Imports:     mapResult.Imports,
 		IsNonNull:   field.Type != nil && field.Type.NonNull,
-	}, nil
+
+		AnnotatedType:     mapResult.AnnotatedType,
+		AnnotationImports: mapResult.AnnotationImports,
+	}
+
+	if !fc.ShouldSkip() {
+		if parser.ExtractJavaTypeDirective(field.Directives) == nil {
+			if err := tc.TypeMapper.ValidateMapping(field.Type); err != nil {
+				tc.warnMapping(field, err)
+			}
+		}
+		if err := tc.TypeMapper.ValidatePrecision(field, mapResult); err != nil {
+			tc.warnMapping(field, err)
+		}
+	}
+
+	return fc, nil
 }
 
 // ShouldSkip returns true if the field should be skipped.
@@ -119,9 +206,35 @@ func (fc *FieldContext) ShouldSkip() bool {
 		}
 	}
 
+	// Skip the federation _service and _entities query fields
+	if parser.IsFederationQueryField(fc.Field.Name) && fc.Schema.IsRootType(fc.TypeDef.Name) {
+		return true
+	}
+
+	// Skip fields hidden from the supergraph if configured
+	if fc.Config.Features.Federation.SkipInaccessible && fc.Field.HasDirective(parser.DirectiveInaccessible) {
+		return true
+	}
+
 	return false
 }
 
+// NullnessAnnotation returns the nullness annotation of the field's type and
+// its import. Primitive types are never annotated.
+func (fc *FieldContext) NullnessAnnotation() (string, []string) {
+	if typemap.IsPrimitive(fc.JavaType) {
+		return "", nil
+	}
+	return fc.NullabilityGen.GenerateAnnotation(fc.IsNonNull)
+}
+
+// resolveTypes adds the imports of the field's type and its annotations,
+// and qualifies the names they shadow.
+func (fc *FieldContext) resolveTypes() {
+	fc.JavaType = fc.TypeContext.Imports.Resolve(fc.JavaType, fc.Imports)
+	fc.AnnotatedType = fc.TypeContext.Imports.Resolve(fc.AnnotatedType, append(fc.Imports[:len(fc.Imports):len(fc.Imports)], fc.AnnotationImports...))
+}
+
 // IsBooleanType returns true if the field is a boolean type.
 func (fc *FieldContext) IsBooleanType() bool {
 	return fc.JavaType == "boolean" || fc.JavaType == "Boolean"
@@ -0,0 +1,278 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/annotations"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+	"github.com/source-c/go-gql2j/internal/typemap"
+)
+
+// controllerSuffix is appended to the Java name of a type to name its
+// controller.
+const controllerSuffix = "Controller"
+
+// springGraphQLAnnotations is the package of the Spring for GraphQL
+// controller annotations.
+const springGraphQLAnnotations = "org.springframework.graphql.data.method.annotation."
+
+// controllerFields returns the fields of an object type that get a handler
+// method in its controller: all fields of the root types and the fields
+// with arguments of the other types. Skipped fields, introspection fields
+// and those federation adds to the query type are left out.
+func controllerFields(tc *TypeContext) ([]*FieldContext, error) {
+	root := tc.Schema.IsRootType(tc.TypeDef.Name)
+
+	var fields []*FieldContext
+	for _, field := range tc.TypeDef.Fields {
+		if strings.HasPrefix(field.Name, "__") ||
+			root && parser.IsFederationQueryField(field.Name) ||
+			!root && len(field.Arguments) == 0 {
+			continue
+		}
+		fc, err := NewFieldContext(tc, field)
+		if err != nil {
+			return nil, errors.NewGenerateError("failed to create field context", err).
+				WithTypeName(tc.TypeDef.Name).
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
+		}
+		if !fc.ShouldSkip() {
+			fields = append(fields, fc)
+		}
+	}
+	return fields, nil
+}
+
+// generateControllerFiles generates an abstract Spring for GraphQL
+// controller for each root type and each object type with fields taking
+// arguments, if enabled in the configuration. Controllers that would
+// collide with a generated type are not generated.
+func (g *Generator) generateControllerFiles(ctx *Context) ([]*GeneratedFile, []error) {
+	if !ctx.Config.Features.Controllers.Enabled {
+		return nil, nil
+	}
+
+	// File names may not differ only in case
+	taken := make(map[string]bool)
+	for _, name := range ctx.javaTypeNames {
+		taken[strings.ToLower(name)] = true
+	}
+
+	var files []*GeneratedFile
+	var errs []error
+	for _, typeDef := range ctx.namedTypes() {
+		if typeDef.Kind != parser.TypeKindObject || len(ctx.nameErrors[typeDef.Name]) > 0 {
+			continue
+		}
+		tc := NewTypeContext(ctx, typeDef)
+		fields, err := controllerFields(tc)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		name := tc.TypeName + controllerSuffix
+		if taken[strings.ToLower(name)] {
+			err := errors.NewGenerateError("controller "+name+" collides with a generated type", nil).
+				WithTypeName(typeDef.Name)
+			err.Location = typeDef.Location
+			err.Hint = "use @javaName to choose another name for " + typeDef.Name
+			errs = append(errs, err)
+			continue
+		}
+		taken[strings.ToLower(name)] = true
+
+		file, err := g.generateController(tc, name, fields)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		files = append(files, file)
+	}
+
+	return files, errs
+}
+
+// generateController generates the abstract controller of a type, with an
+// abstract handler method per field. Fields of the root types are mapped
+// with @QueryMapping, @MutationMapping and @SubscriptionMapping, the others
+// with @SchemaMapping and get the object they belong to as first parameter.
+func (g *Generator) generateController(tc *TypeContext, className string, fields []*FieldContext) (*GeneratedFile, error) {
+	tc.Imports.Reserve(className)
+
+	var mapping string
+	switch tc.TypeDef.Name {
+	case tc.Schema.QueryType:
+		mapping = "QueryMapping"
+	case tc.Schema.MutationType:
+		mapping = "MutationMapping"
+	case tc.Schema.SubscriptionType:
+		mapping = "SubscriptionMapping"
+	default:
+		mapping = "SchemaMapping"
+	}
+	mappingAnnotation := tc.Imports.Resolve("@"+mapping, []string{springGraphQLAnnotations + mapping})
+
+	var body strings.Builder
+	usedMethods := make(map[string]bool)
+	for _, fc := range fields {
+		field := fc.Field
+
+		name := fc.FieldName
+		for base, n := name, 2; usedMethods[name]; n++ {
+			name = base + strconv.Itoa(n)
+		}
+		usedMethods[name] = true
+
+		returnType, err := g.controllerType(tc, field.Type, field)
+		if err != nil {
+			return nil, errors.NewGenerateError("failed to map field type", err).
+				WithTypeName(tc.TypeDef.Name).
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
+		}
+		if mapping == "SubscriptionMapping" {
+			returnType = annotateNullness(tc, typemap.BoxType(returnType), field.Type.NonNull, true)
+			returnType = tc.Imports.Resolve("Publisher<"+returnType+">", []string{"org.reactivestreams.Publisher"})
+		} else {
+			returnType = annotateNullness(tc, returnType, field.Type.NonNull, false)
+		}
+
+		var parameters []string
+		usedParameters := make(map[string]bool)
+		if mapping == "SchemaMapping" {
+			source := EscapeJavaKeyword(tc.NamingHelper.acronyms.camelCase(tc.TypeName))
+			usedParameters[source] = true
+			parameters = append(parameters, tc.TypeName+" "+source)
+		}
+		for _, arg := range field.Arguments {
+			argType, err := g.controllerType(tc, arg.Type, nil)
+			if err != nil {
+				return nil, errors.NewGenerateError("failed to map argument type", err).
+					WithTypeName(tc.TypeDef.Name).
+					WithFieldName(field.Name).
+					WithLocation(field.Location)
+			}
+
+			base := EscapeJavaKeyword(tc.NamingHelper.acronyms.camelCase(arg.Name))
+			param := base
+			for n := 2; usedParameters[param]; n++ {
+				param = base + strconv.Itoa(n)
+			}
+			usedParameters[param] = true
+
+			argument := tc.Imports.Resolve("@Argument", []string{springGraphQLAnnotations + "Argument"})
+			if param != arg.Name {
+				argument += "(" + strconv.Quote(arg.Name) + ")"
+			}
+			argType = annotateNullness(tc, argType, arg.Type.NonNull, false)
+			parameters = append(parameters, argument+" "+argType+" "+param)
+		}
+
+		if field.Description != "" {
+			body.WriteString(g.classGen.fieldGen.generateJavadoc(field.Description, "    "))
+		}
+		if deprecated, deprecatedImport := tc.CustomAnnotation.GenerateDeprecatedAnnotation(field.Directives); deprecated != "" {
+			body.WriteString("    " + tc.Imports.Resolve(deprecated, []string{deprecatedImport}) + "\n")
+		}
+		body.WriteString("    " + mappingAnnotation)
+		if mapping == "SchemaMapping" {
+			body.WriteString("(typeName = " + strconv.Quote(tc.TypeDef.Name) + ", field = " + strconv.Quote(field.Name) + ")")
+		} else if name != field.Name {
+			body.WriteString("(" + strconv.Quote(field.Name) + ")")
+		}
+		body.WriteString("\n")
+		body.WriteString("    public abstract " + returnType + " " + name + "(" + strings.Join(parameters, ", ") + ");\n\n")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("package ")
+	sb.WriteString(tc.Config.Output.Package)
+	sb.WriteString(";\n\n")
+
+	if imports := tc.Imports.GenerateImportBlock(); imports != "" {
+		sb.WriteString(imports)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("/**\n")
+	sb.WriteString(" * Base class of the Spring for GraphQL controller of " + tc.TypeDef.Name + ". Extend it\n")
+	sb.WriteString(" * in a {@code @Controller} class implementing its methods.\n")
+	sb.WriteString(" */\n")
+	sb.WriteString("public abstract class " + className + " {\n\n")
+	sb.WriteString(strings.TrimSuffix(body.String(), "\n"))
+	sb.WriteString("}\n")
+
+	return &GeneratedFile{
+		FileName: className + ".java",
+		Content:  sb.String(),
+	}, nil
+}
+
+// controllerType maps the type of a field, if given, or of an argument to
+// the type of a handler method. Nullable types are boxed rather than
+// wrapped in Optional, as Spring passes and expects null for them. Only the
+// imports the type references are added, not those of field initializers
+// such as java.util.ArrayList.
+func (g *Generator) controllerType(tc *TypeContext, typeRef *parser.TypeRef, field *parser.FieldDef) (string, error) {
+	nonNull := *typeRef
+	nonNull.NonNull = true
+
+	var result *typemap.MapResult
+	var err error
+	if field != nil {
+		mapped := *field
+		mapped.Type = &nonNull
+		result, err = tc.TypeMapper.MapFieldType(&mapped)
+	} else {
+		result, err = tc.TypeMapper.MapType(&nonNull)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	javaType := result.JavaType
+	if result.IsPrimitive && !typeRef.NonNull {
+		javaType = typemap.BoxType(javaType)
+	}
+	return tc.Imports.Resolve(javaType, referencedImports(javaType, result.Imports)), nil
+}
+
+// annotateNullness annotates a type of a handler method with its nullness,
+// so that nullable types stay nullable in @NullMarked packages. Declaration
+// annotations cannot annotate the element type of a subscription's
+// Publisher, which is left unannotated then.
+func annotateNullness(tc *TypeContext, javaType string, nonNull, element bool) string {
+	if typemap.IsPrimitive(javaType) {
+		return javaType
+	}
+	annotation, imports := tc.NullabilityGen.GenerateAnnotation(nonNull)
+	if annotation == "" {
+		return javaType
+	}
+	if tc.NullabilityGen.IsTypeUse() {
+		return annotations.AnnotateType(javaType, tc.Imports.Resolve(annotation, imports))
+	}
+	if element {
+		return javaType
+	}
+	return tc.Imports.Resolve(annotation, imports) + " " + javaType
+}
+
+// referencedImports returns the imports whose simple name a Java type
+// references.
+func referencedImports(javaType string, imports []string) []string {
+	var result []string
+	for _, imp := range imports {
+		if qualifyName(javaType, imp) != javaType {
+			result = append(result, imp)
+		}
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+const controllerSchema = `
+directive @skip on OBJECT | FIELD_DEFINITION
+type Query {
+  "Finds a user."
+  user(id: ID!, includeDeleted: Boolean): User
+  count: Int!
+  class: String
+  hidden: String @skip
+}
+type Mutation { createUser(input: UserInput!): User! }
+type Subscription { ticks: Int! }
+type User {
+  id: ID!
+  name: String
+  score(round: Boolean!, user: String): Float!
+}
+type Post { id: ID! }
+input UserInput { name: String! }
+`
+
+func controllersConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Features.Controllers.Enabled = true
+	return cfg
+}
+
+func TestControllers(t *testing.T) {
+	result := generateNamed(t, controllersConfig(), controllerSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.NotContains(t, files, "PostController.java")
+	assert.NotContains(t, files, "UserInputController.java")
+
+	assert.Equal(t, `package com.example.model;
+
+import org.springframework.graphql.data.method.annotation.Argument;
+import org.springframework.graphql.data.method.annotation.QueryMapping;
+
+/**
+ * Base class of the Spring for GraphQL controller of Query. Extend it
+ * in a {@code @Controller} class implementing its methods.
+ */
+public abstract class QueryController {
+
+    /**
+     * Finds a user.
+     */
+    @QueryMapping
+    public abstract User user(@Argument String id, @Argument Boolean includeDeleted);
+
+    @QueryMapping
+    public abstract int count();
+
+    @QueryMapping("class")
+    public abstract String _class();
+}
+`, files["QueryController.java"])
+
+	assert.Contains(t, files["MutationController.java"], `    @MutationMapping
+    public abstract User createUser(@Argument UserInput input);
+`)
+	assert.Contains(t, files["SubscriptionController.java"], "import org.reactivestreams.Publisher;\n")
+	assert.Contains(t, files["SubscriptionController.java"], `    @SubscriptionMapping
+    public abstract Publisher<Integer> ticks();
+`)
+	assert.Contains(t, files["UserController.java"], `public abstract class UserController {
+
+    @SchemaMapping(typeName = "User", field = "score")
+    public abstract double score(User user, @Argument boolean round, @Argument("user") String user2);
+}
+`)
+}
+
+func TestControllers_Collision(t *testing.T) {
+	result := generateNamed(t, controllersConfig(), `
+type Query { user: User, controller: QueryController }
+type User { id: ID }
+type QueryController { id: ID }
+`)
+
+	assert.Equal(t, []string{"controller QueryController collides with a generated type"}, errorMessages(result.Errors))
+}
+
+func TestControllers_Disabled(t *testing.T) {
+	result := generateNamed(t, config.DefaultConfig(), controllerSchema)
+	require.Empty(t, result.Errors)
+
+	assert.NotContains(t, fileContents(result), "QueryController.java")
+}
+
+func TestControllers_ListImports(t *testing.T) {
+	cfg := controllersConfig()
+	cfg.TypeMappings.Scalars = map[string]config.ScalarMapping{
+		"Date": {JavaType: "Date", Imports: []string{"java.util.Date"}},
+	}
+
+	result := generateNamed(t, cfg, `
+scalar Date
+type Query { users(ids: [ID!]!): [User!]!, days: [Date] }
+type User { id: ID!, tags(first: Int): [String] }
+`)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.Contains(t, files["QueryController.java"], "    public abstract List<User> users(@Argument List<String> ids);\n")
+	assert.Contains(t, files["QueryController.java"], "    public abstract List<Date> days();\n")
+	assert.Contains(t, files["QueryController.java"], "import java.util.Date;\n")
+	assert.Contains(t, files["QueryController.java"], "import java.util.List;\n")
+	assert.NotContains(t, files["QueryController.java"], "ArrayList")
+	assert.NotContains(t, files["UserController.java"], "ArrayList")
+	assert.Equal(t, 2, GetStats(result.Files, nil).TotalTypes)
+}
+
+func TestControllers_Nullability(t *testing.T) {
+	cfg := controllersConfig()
+	cfg.Features.Validation.Enabled = true
+	cfg.Features.Validation.NotNullOnNonNull = true
+	cfg.Java.NullableHandling = config.NullableAnnotation
+	schema := `
+type Query { user(id: ID!, name: String, limit: Int): User, count: Int! }
+type Subscription { updates: User }
+type User { id: ID! }
+`
+
+	result := generateNamed(t, cfg, schema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.Contains(t, files["QueryController.java"], "    public abstract @Nullable User user(@Argument String id, @Argument @Nullable String name, @Argument @Nullable Integer limit);\n")
+	assert.Contains(t, files["QueryController.java"], "    public abstract int count();\n")
+	assert.Contains(t, files["QueryController.java"], "import org.jspecify.annotations.Nullable;\n")
+	assert.NotContains(t, files["QueryController.java"], "@NotNull")
+	assert.Contains(t, files["SubscriptionController.java"], "    public abstract Publisher<@Nullable User> updates();\n")
+
+	// Declaration annotations precede the type, but not a Publisher's element
+	cfg.Java.Nullability.Library = config.NullabilityJSR305
+	result = generateNamed(t, cfg, schema)
+	require.Empty(t, result.Errors)
+	files = fileContents(result)
+
+	assert.Contains(t, files["QueryController.java"], "    public abstract @Nullable User user(@Argument String id, @Argument @Nullable String name, @Argument @Nullable Integer limit);\n")
+	assert.Contains(t, files["QueryController.java"], "import javax.annotation.Nullable;\n")
+	assert.Contains(t, files["SubscriptionController.java"], "    public abstract Publisher<User> updates();\n")
+}
@@ -51,12 +51,16 @@ func (g *EnumGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string,
 	sb.WriteString(tc.TypeName)
 	sb.WriteString(" {\n")
 
-	// Generate enum values
-	for i, enumValue := range typeDef.EnumValues {
-		// Check if value should be skipped
-		if parser.ExtractSkipDirective(enumValue.Directives) != nil {
-			continue
+	// Collect the values to generate
+	var enumValues []*parser.EnumValueDef
+	for _, enumValue := range typeDef.EnumValues {
+		if !tc.ShouldSkipEnumValue(enumValue) {
+			enumValues = append(enumValues, enumValue)
 		}
+	}
+
+	// Generate enum values
+	for i, enumValue := range enumValues {
 
 		// Generate Javadoc for value
 		if enumValue.Description != "" {
@@ -78,7 +82,7 @@ func (g *EnumGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string,
 		sb.WriteString(valueName)
 
 		// Add comma or semicolon
-		if i < len(typeDef.EnumValues)-1 {
+		if i < len(enumValues)-1 {
 			sb.WriteString(",\n")
 		} else {
 			sb.WriteString(";\n")
@@ -106,14 +110,13 @@ func (g *EnumGenerator) generateEnumAnnotations(tc *TypeContext) []string {
 	var annotations []string
 
 	// Deprecated annotation
-	if deprecated, _ := tc.CustomAnnotation.GenerateDeprecatedAnnotation(tc.TypeDef.Directives); deprecated != "" {
-		annotations = append(annotations, deprecated)
+	if deprecated, deprecatedImport := tc.CustomAnnotation.GenerateDeprecatedAnnotation(tc.TypeDef.Directives); deprecated != "" {
+		annotations = append(annotations, tc.Imports.Resolve(deprecated, []string{deprecatedImport}))
 	}
 
 	// Custom annotations
 	customAnns, customImports := tc.CustomAnnotation.GenerateTypeAnnotations(tc.TypeDef)
-	annotations = append(annotations, customAnns...)
-	tc.Imports.AddAll(customImports)
+	annotations = append(annotations, tc.Imports.ResolveAll(customAnns, customImports)...)
 
 	return annotations
 }
@@ -122,14 +125,21 @@ func (g *EnumGenerator) generateValueAnnotations(tc *TypeContext, enumValue *par
 	var annotations []string
 
 	// Deprecated annotation
-	if deprecated, _ := tc.CustomAnnotation.GenerateDeprecatedAnnotation(enumValue.Directives); deprecated != "" {
-		annotations = append(annotations, deprecated)
+	if deprecated, deprecatedImport := tc.CustomAnnotation.GenerateDeprecatedAnnotation(enumValue.Directives); deprecated != "" {
+		annotations = append(annotations, tc.Imports.Resolve(deprecated, []string{deprecatedImport}))
 	}
 
 	// Custom annotations
 	customAnns, customImports := tc.CustomAnnotation.GenerateEnumValueAnnotations(enumValue)
-	annotations = append(annotations, customAnns...)
-	tc.Imports.AddAll(customImports)
+
+	// Jackson annotation keeping the schema name of renamed values
+	valueName := tc.NamingHelper.GetEnumValueName(enumValue)
+	jsonProperty, jacksonImports := tc.JacksonGen.GeneratePropertyAnnotation(enumValue.Name, valueName, customAnns)
+	if jsonProperty != "" {
+		annotations = append(annotations, tc.Imports.Resolve(jsonProperty, jacksonImports))
+	}
+
+	annotations = append(annotations, tc.Imports.ResolveAll(customAnns, customImports)...)
 
 	return annotations
 }
@@ -222,3 +222,28 @@ func indexOf(s, substr string) int {
 	}
 	return -1
 }
+
+func TestEnumGenerator_Generate_PascalCaseWithJsonProperty(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.Naming.EnumValueCase = config.EnumValueCasePascal
+	cfg.Features.Jackson.Enabled = true
+	schema := &parser.Schema{Types: map[string]*parser.TypeDef{}}
+	ctx := NewContext(cfg, schema)
+
+	typeDef := &parser.TypeDef{
+		Name: "Status",
+		Kind: parser.TypeKindEnum,
+		EnumValues: []*parser.EnumValueDef{
+			{Name: "IN_PROGRESS"},
+			{Name: "Done"},
+		},
+	}
+
+	content, err := NewEnumGenerator().Generate(ctx, typeDef)
+	require.NoError(t, err)
+
+	assert.Contains(t, content, "import com.fasterxml.jackson.annotation.JsonProperty;")
+	assert.Contains(t, content, "    @JsonProperty(\"IN_PROGRESS\")\n    InProgress,")
+	assert.Contains(t, content, "\n    Done;")
+	assert.NotContains(t, content, "@JsonProperty(\"Done\")")
+}
@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// EntitiesResolverName is the name of the generated _entities resolver interface.
+const EntitiesResolverName = "EntitiesResolver"
+
+// entityReference is a generated reference class for one @key of an entity.
+type entityReference struct {
+	Entity  *parser.TypeDef
+	Key     *parser.KeyDirectiveInfo
+	TypeDef *parser.TypeDef
+}
+
+// generateFederationFiles generates the entity reference classes and the
+// _entities resolver interface, if enabled in the configuration.
+func (g *Generator) generateFederationFiles(ctx *Context) ([]*GeneratedFile, []error) {
+	federation := ctx.Config.Features.Federation
+	if !federation.EntityReferences && !federation.EntitiesResolver {
+		return nil, nil
+	}
+
+	var files []*GeneratedFile
+	var errs []error
+
+	// Entities whose keys are all resolvable: false are only referenced
+	// from this subgraph and never resolved by it
+	var entities []*parser.TypeDef
+	for _, entity := range ctx.Schema.EntityTypes() {
+		if NewTypeContext(ctx, entity).ShouldSkip() {
+			continue
+		}
+		for _, key := range parser.ExtractKeyDirectives(entity.Directives) {
+			if key.Resolvable {
+				entities = append(entities, entity)
+				break
+			}
+		}
+	}
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	references := buildEntityReferences(entities)
+
+	if federation.EntityReferences {
+		for _, ref := range references {
+			file, err := g.generateType(ctx, ref.TypeDef)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if file != nil {
+				files = append(files, file)
+			}
+		}
+	}
+
+	if federation.EntitiesResolver {
+		files = append(files, g.generateEntitiesResolver(ctx, entities, references))
+	}
+
+	return files, errs
+}
+
+// buildEntityReferences creates one reference type per resolvable @key.
+// An entity with a single key gets <Type>Reference; an entity with several
+// keys gets <Type>By<KeyFields>Reference for each of them.
+func buildEntityReferences(entities []*parser.TypeDef) []*entityReference {
+	var result []*entityReference
+
+	for _, entity := range entities {
+		var keys []*parser.KeyDirectiveInfo
+		for _, key := range parser.ExtractKeyDirectives(entity.Directives) {
+			if key.Resolvable {
+				keys = append(keys, key)
+			}
+		}
+
+		for _, key := range keys {
+			suffix := "Reference"
+			if len(keys) > 1 {
+				var parts []string
+				for _, name := range key.FieldNames() {
+					parts = append(parts, ToPascalCase(name))
+				}
+				suffix = "By" + strings.Join(parts, "And") + suffix
+			}
+
+			refDef := &parser.TypeDef{
+				Name:        entity.Name + suffix,
+				Kind:        parser.TypeKindObject,
+				Description: "Reference to a " + entity.Name + " entity by its key fields \"" + key.Fields + "\".",
+				Location:    entity.Location,
+			}
+
+			// Keep the entity's Java name for the reference
+			if javaName := parser.ExtractJavaNameDirective(entity.Directives); javaName != nil {
+				refDef.Directives = []*parser.DirectiveDef{{
+					Name:      parser.DirectiveJavaName,
+					Arguments: map[string]interface{}{"name": javaName.Name + suffix},
+				}}
+			}
+
+			for _, name := range key.FieldNames() {
+				if field := entity.GetField(name); field != nil {
+					refDef.Fields = append(refDef.Fields, field)
+				}
+			}
+
+			result = append(result, &entityReference{Entity: entity, Key: key, TypeDef: refDef})
+		}
+	}
+
+	return result
+}
+
+// generateEntitiesResolver generates an interface with one resolve method
+// per entity reference, or per entity taking the raw representation map
+// when reference classes are disabled.
+func (g *Generator) generateEntitiesResolver(ctx *Context, entities []*parser.TypeDef, references []*entityReference) *GeneratedFile {
+	resolverDef := &parser.TypeDef{
+		Name: EntitiesResolverName,
+		Kind: parser.TypeKindInterface,
+	}
+	imports := ctx.importManager()
+
+	var methods strings.Builder
+	if ctx.Config.Features.Federation.EntityReferences {
+		for _, ref := range references {
+			entityName := ctx.NamingHelper.GetTypeName(ref.Entity)
+			refName := ctx.NamingHelper.GetTypeName(ref.TypeDef)
+			methodName := "resolve" + strings.TrimSuffix(refName, ctx.Config.Java.Naming.ClassSuffix)
+			methodName = strings.TrimSuffix(methodName, "Reference")
+
+			methods.WriteString("    /**\n")
+			methods.WriteString("     * Resolves a " + ref.Entity.Name + " by its key fields \"" + ref.Key.Fields + "\".\n")
+			methods.WriteString("     */\n")
+			methods.WriteString("    " + entityName + " " + methodName + "(" + refName + " reference);\n\n")
+		}
+	} else {
+		representation := imports.Resolve("Map<String, Object>", []string{"java.util.Map", "java.lang.String", "java.lang.Object"})
+		for _, entity := range entities {
+			entityName := ctx.NamingHelper.GetTypeName(entity)
+
+			methods.WriteString("    /**\n")
+			methods.WriteString("     * Resolves a " + entity.Name + " from its entity representation.\n")
+			methods.WriteString("     */\n")
+			methodName := "resolve" + strings.TrimSuffix(entityName, ctx.Config.Java.Naming.ClassSuffix)
+			methods.WriteString("    " + entityName + " " + methodName + "(" + representation + " representation);\n\n")
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("package ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n\n")
+
+	if block := imports.GenerateImportBlock(); block != "" {
+		sb.WriteString(block)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("/**\n")
+	sb.WriteString(" * Resolves entity references for the Apollo Federation _entities query.\n")
+	sb.WriteString(" */\n")
+	sb.WriteString("public interface ")
+	sb.WriteString(EntitiesResolverName)
+	sb.WriteString(" {\n\n")
+	sb.WriteString(strings.TrimSuffix(methods.String(), "\n"))
+	sb.WriteString("}\n")
+
+	return &GeneratedFile{
+		FileName: EntitiesResolverName + ".java",
+		Content:  sb.String(),
+		TypeDef:  resolverDef,
+	}
+}
@@ -0,0 +1,137 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+const federationSchema = `
+extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key", "@inaccessible"])
+
+type Query {
+  _service: _Service!
+  _entities(representations: [_Any!]!): [_Entity]!
+  product(id: ID!): Product
+}
+
+type Product @key(fields: "id") @key(fields: "sku package") {
+  id: ID!
+  sku: String!
+  package: String!
+  internalCode: String @inaccessible
+}
+
+type User @key(fields: "email") @javaName(name: "Account") {
+  email: ID!
+}
+
+type Warehouse @key(fields: "id", resolvable: false) {
+  id: ID!
+}
+
+enum Status {
+  ACTIVE
+  SECRET @inaccessible
+}
+
+directive @javaName(name: String!) on OBJECT
+`
+
+func generateFederation(t *testing.T, cfg *config.Config) map[string]*GeneratedFile {
+	schema, err := parser.NewParser().Parse(federationSchema, "subgraph.graphql")
+	require.NoError(t, err)
+
+	files, err := NewGenerator(cfg).Generate(schema)
+	require.NoError(t, err)
+
+	byName := make(map[string]*GeneratedFile)
+	for _, f := range files {
+		byName[f.FileName] = f
+	}
+	return byName
+}
+
+func TestGenerator_Federation_SkipsInfrastructure(t *testing.T) {
+	cfg := config.DefaultConfig()
+	files := generateFederation(t, cfg)
+
+	assert.NotContains(t, files, "_Any.java")
+	assert.NotContains(t, files, "_Service.java")
+	assert.NotContains(t, files, "link__Purpose.java")
+	assert.NotContains(t, files, "EntitiesResolver.java")
+	assert.NotContains(t, files, "ProductByIdReference.java")
+
+	query := files["Query.java"]
+	require.NotNil(t, query)
+	assert.NotContains(t, query.Content, "_service")
+	assert.NotContains(t, query.Content, "_entities")
+
+	// @inaccessible is kept unless configured otherwise
+	assert.Contains(t, files["Product.java"].Content, "internalCode")
+	assert.Contains(t, files["Status.java"].Content, "SECRET")
+}
+
+func TestGenerator_Federation_SkipInaccessible(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Federation.SkipInaccessible = true
+	files := generateFederation(t, cfg)
+
+	assert.NotContains(t, files["Product.java"].Content, "internalCode")
+	assert.NotContains(t, files["Status.java"].Content, "SECRET")
+	assert.Contains(t, files["Status.java"].Content, "ACTIVE;")
+}
+
+func TestGenerator_Federation_EntityReferences(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Federation.EntityReferences = true
+	files := generateFederation(t, cfg)
+
+	byID := files["ProductByIdReference.java"]
+	require.NotNil(t, byID)
+	assert.Contains(t, byID.Content, "public class ProductByIdReference")
+	assert.Contains(t, byID.Content, "private String id;")
+	assert.NotContains(t, byID.Content, "sku")
+
+	bySku := files["ProductBySkuAndPackageReference.java"]
+	require.NotNil(t, bySku)
+	assert.Contains(t, bySku.Content, "private String sku;")
+
+	// The reference follows the entity's Java name
+	assert.Contains(t, files, "AccountReference.java")
+
+	// Keys with resolvable: false get no reference
+	assert.NotContains(t, files, "WarehouseReference.java")
+}
+
+func TestGenerator_Federation_EntitiesResolver(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Federation.EntityReferences = true
+	cfg.Features.Federation.EntitiesResolver = true
+	files := generateFederation(t, cfg)
+
+	resolver := files["EntitiesResolver.java"]
+	require.NotNil(t, resolver)
+	assert.Contains(t, resolver.Content, "public interface EntitiesResolver")
+	assert.Contains(t, resolver.Content, "Product resolveProductById(ProductByIdReference reference);")
+	assert.Contains(t, resolver.Content, "Product resolveProductBySkuAndPackage(ProductBySkuAndPackageReference reference);")
+	assert.Contains(t, resolver.Content, "Account resolveAccount(AccountReference reference);")
+	assert.NotContains(t, resolver.Content, "Warehouse")
+}
+
+func TestGenerator_Federation_EntitiesResolverWithoutReferences(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Federation.EntitiesResolver = true
+	files := generateFederation(t, cfg)
+
+	resolver := files["EntitiesResolver.java"]
+	require.NotNil(t, resolver)
+	assert.Contains(t, resolver.Content, "import java.util.Map;")
+	assert.Contains(t, resolver.Content, "Product resolveProduct(Map<String, Object> representation);")
+	assert.Contains(t, resolver.Content, "Account resolveAccount(Map<String, Object> representation);")
+	assert.NotContains(t, resolver.Content, "Warehouse")
+}
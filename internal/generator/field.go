@@ -3,6 +3,7 @@ package generator
 import (
 	"strings"
 
+	"github.com/source-c/go-gql2j/internal/annotations"
 	"github.com/source-c/go-gql2j/internal/errors"
 	"github.com/source-c/go-gql2j/internal/parser"
 )
@@ -19,8 +20,8 @@ func NewFieldGenerator() *FieldGenerator {
 func (g *FieldGenerator) GenerateField(fc *FieldContext) string {
 	var sb strings.Builder
 
-	// Add field imports
-	fc.TypeContext.Imports.AddAll(fc.Imports)
+	// Add field imports; getters and setters use the resolved type
+	fc.resolveTypes()
 
 	// Generate Javadoc if description exists
 	if fc.Field.Description != "" {
@@ -28,8 +29,8 @@ func (g *FieldGenerator) GenerateField(fc *FieldContext) string {
 	}
 
 	// Generate annotations
-	annotations := g.generateFieldAnnotations(fc)
-	for _, ann := range annotations {
+	fieldAnnotations := g.generateFieldAnnotations(fc)
+	for _, ann := range fieldAnnotations {
 		sb.WriteString("    ")
 		sb.WriteString(ann)
 		sb.WriteString("\n")
@@ -44,7 +45,7 @@ func (g *FieldGenerator) GenerateField(fc *FieldContext) string {
 	} else {
 		sb.WriteString("    ")
 	}
-	sb.WriteString(fc.JavaType)
+	sb.WriteString(g.declaredType(fc))
 	sb.WriteString(" ")
 	sb.WriteString(fc.FieldName)
 	sb.WriteString(";\n")
@@ -56,23 +57,70 @@ func (g *FieldGenerator) generateFieldAnnotations(fc *FieldContext) []string {
 	var annotations []string
 
 	// Deprecated annotation
-	if deprecated, _ := fc.CustomAnnotation.GenerateDeprecatedAnnotation(fc.Field.Directives); deprecated != "" {
-		annotations = append(annotations, deprecated)
+	if deprecated, deprecatedImport := fc.CustomAnnotation.GenerateDeprecatedAnnotation(fc.Field.Directives); deprecated != "" {
+		annotations = append(annotations, fc.TypeContext.Imports.Resolve(deprecated, []string{deprecatedImport}))
+	}
+
+	// Nullness annotation of declaration annotation libraries
+	if nullness := g.nullnessDeclarationAnnotation(fc); nullness != "" {
+		annotations = append(annotations, nullness)
+	}
+
+	// JPA annotations of entity fields
+	if fc.PersistenceGen.IsEntity(fc.TypeDef) {
+		persistenceAnns, persistenceImports := fc.PersistenceGen.GenerateFieldAnnotations(fc.Field, fc.isEntityType)
+		annotations = append(annotations, fc.TypeContext.Imports.ResolveAll(persistenceAnns, persistenceImports)...)
 	}
 
 	// Validation annotations
 	validationAnns, validationImports := fc.ValidationGen.GenerateFieldAnnotations(fc.Field, fc.IsNonNull)
-	annotations = append(annotations, validationAnns...)
-	fc.TypeContext.Imports.AddAll(validationImports)
+	annotations = append(annotations, fc.TypeContext.Imports.ResolveAll(validationAnns, validationImports)...)
+
+	// Cascaded validation of nested objects
+	if parser.ExtractJavaTypeDirective(fc.Field.Directives) == nil {
+		if valid, validImports := fc.ValidationGen.GenerateCascadeAnnotation(fc.Field.Type, fc.TypeMapper.IsGeneratedType); valid != "" {
+			annotations = append(annotations, fc.TypeContext.Imports.Resolve(valid, validImports))
+		}
+	}
 
 	// Custom annotations
 	customAnns, customImports := fc.CustomAnnotation.GenerateFieldAnnotations(fc.Field)
-	annotations = append(annotations, customAnns...)
-	fc.TypeContext.Imports.AddAll(customImports)
+
+	// Jackson annotation keeping the schema name of renamed fields
+	jsonProperty, jacksonImports := fc.JacksonGen.GeneratePropertyAnnotation(fc.Field.Name, fc.FieldName, customAnns)
+	if jsonProperty != "" {
+		annotations = append(annotations, fc.TypeContext.Imports.Resolve(jsonProperty, jacksonImports))
+	}
+
+	annotations = append(annotations, fc.TypeContext.Imports.ResolveAll(customAnns, customImports)...)
 
 	return annotations
 }
 
+// declaredType returns the type of the field with the annotations of its
+// collection elements, annotated with its nullness if the nullness
+// annotations are type-use annotations.
+func (g *FieldGenerator) declaredType(fc *FieldContext) string {
+	if !fc.NullabilityGen.IsTypeUse() {
+		return fc.AnnotatedType
+	}
+	annotation, imports := fc.NullnessAnnotation()
+	return fc.TypeContext.Imports.Resolve(annotations.AnnotateType(fc.AnnotatedType, annotation), imports)
+}
+
+// nullnessDeclarationAnnotation returns the nullness annotation of the field
+// if the nullness annotations are declaration annotations, or "".
+func (g *FieldGenerator) nullnessDeclarationAnnotation(fc *FieldContext) string {
+	if fc.NullabilityGen.IsTypeUse() {
+		return ""
+	}
+	annotation, imports := fc.NullnessAnnotation()
+	if annotation == "" {
+		return ""
+	}
+	return fc.TypeContext.Imports.Resolve(annotation, imports)
+}
+
 func (g *FieldGenerator) generateJavadoc(description string, indent string) string {
 	var sb strings.Builder
 	sb.WriteString(indent)
@@ -98,8 +146,13 @@ func (g *FieldGenerator) GenerateGetter(fc *FieldContext) string {
 
 	methodName := fc.NamingHelper.GetGetterName(fc.FieldName, fc.IsBooleanType())
 
+	if nullness := g.nullnessDeclarationAnnotation(fc); nullness != "" {
+		sb.WriteString("    ")
+		sb.WriteString(nullness)
+		sb.WriteString("\n")
+	}
 	sb.WriteString("    public ")
-	sb.WriteString(fc.JavaType)
+	sb.WriteString(g.declaredType(fc))
 	sb.WriteString(" ")
 	sb.WriteString(methodName)
 	sb.WriteString("() {\n")
@@ -120,7 +173,11 @@ func (g *FieldGenerator) GenerateSetter(fc *FieldContext) string {
 	sb.WriteString("    public void ")
 	sb.WriteString(methodName)
 	sb.WriteString("(")
-	sb.WriteString(fc.JavaType)
+	if nullness := g.nullnessDeclarationAnnotation(fc); nullness != "" {
+		sb.WriteString(nullness)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(g.declaredType(fc))
 	sb.WriteString(" ")
 	sb.WriteString(fc.FieldName)
 	sb.WriteString(") {\n")
@@ -141,7 +198,7 @@ func (g *FieldGenerator) GenerateInterfaceMethod(tc *TypeContext, field *parser.
 		return "", errors.NewGenerateError(
 			"failed to create field context",
 			err,
-		).WithTypeName(tc.TypeDef.Name).WithFieldName(field.Name)
+		).WithTypeName(tc.TypeDef.Name).WithFieldName(field.Name).WithLocation(field.Location)
 	}
 
 	if fc.ShouldSkip() {
@@ -151,7 +208,7 @@ func (g *FieldGenerator) GenerateInterfaceMethod(tc *TypeContext, field *parser.
 	var sb strings.Builder
 
 	// Add field imports
-	tc.Imports.AddAll(fc.Imports)
+	fc.resolveTypes()
 
 	// Generate Javadoc if description exists
 	if field.Description != "" {
@@ -159,9 +216,14 @@ func (g *FieldGenerator) GenerateInterfaceMethod(tc *TypeContext, field *parser.
 	}
 
 	// Generate annotations
-	if deprecated, _ := fc.CustomAnnotation.GenerateDeprecatedAnnotation(field.Directives); deprecated != "" {
+	if deprecated, deprecatedImport := fc.CustomAnnotation.GenerateDeprecatedAnnotation(field.Directives); deprecated != "" {
+		sb.WriteString("    ")
+		sb.WriteString(tc.Imports.Resolve(deprecated, []string{deprecatedImport}))
+		sb.WriteString("\n")
+	}
+	if nullness := g.nullnessDeclarationAnnotation(fc); nullness != "" {
 		sb.WriteString("    ")
-		sb.WriteString(deprecated)
+		sb.WriteString(nullness)
 		sb.WriteString("\n")
 	}
 
@@ -169,7 +231,7 @@ func (g *FieldGenerator) GenerateInterfaceMethod(tc *TypeContext, field *parser.
 	methodName := fc.NamingHelper.GetGetterName(fc.FieldName, fc.IsBooleanType())
 
 	sb.WriteString("    ")
-	sb.WriteString(fc.JavaType)
+	sb.WriteString(g.declaredType(fc))
 	sb.WriteString(" ")
 	sb.WriteString(methodName)
 	sb.WriteString("();\n")
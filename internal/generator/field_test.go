@@ -323,3 +323,78 @@ func TestFieldGenerator_GenerateField_PackageVisibility(t *testing.T) {
 	assert.NotContains(t, content, "private")
 	assert.NotContains(t, content, "public")
 }
+
+func TestFieldGenerator_GenerateField_AcronymCaseWithJsonProperty(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.Naming.AcronymCase = config.AcronymCaseCamel
+	cfg.Features.Jackson.Enabled = true
+	schema := &parser.Schema{Types: map[string]*parser.TypeDef{}}
+	ctx := NewContext(cfg, schema)
+	tc := NewTypeContext(ctx, &parser.TypeDef{Name: "User", Kind: parser.TypeKindObject})
+
+	field := &parser.FieldDef{Name: "userID", Type: &parser.TypeRef{Name: "ID", NonNull: true}}
+	fc, err := NewFieldContext(tc, field)
+	require.NoError(t, err)
+
+	content := NewFieldGenerator().GenerateField(fc)
+	assert.Equal(t, "    @JsonProperty(\"userID\")\n    private String userId;\n", content)
+	assert.True(t, tc.Imports.Has("com.fasterxml.jackson.annotation.JsonProperty"))
+}
+
+func TestFieldGenerator_NullabilityAnnotations(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.NullableHandling = config.NullableAnnotation
+	cfg.Java.Nullability.NonNull = true
+	cfg.TypeMappings.Scalars["Timestamp"] = config.ScalarMapping{JavaType: "Date", Imports: []string{"java.util.Date"}}
+	schema := &parser.Schema{Types: map[string]*parser.TypeDef{
+		"Date": {Name: "Date", Kind: parser.TypeKindObject},
+	}}
+	ctx := NewContext(cfg, schema)
+	tc := NewTypeContext(ctx, &parser.TypeDef{Name: "User", Kind: parser.TypeKindObject})
+	g := NewFieldGenerator()
+
+	tests := []struct {
+		typeRef  *parser.TypeRef
+		expected string
+	}{
+		{&parser.TypeRef{Name: "String"}, "    private @Nullable String value;\n"},
+		{&parser.TypeRef{Name: "String", NonNull: true}, "    private @NonNull String value;\n"},
+		{&parser.TypeRef{Name: "Int", NonNull: true}, "    private int value;\n"},
+		{&parser.TypeRef{Name: "Timestamp"}, "    private java.util.@Nullable Date value;\n"},
+		{&parser.TypeRef{Elem: &parser.TypeRef{Name: "Timestamp"}}, "    private @Nullable List<java.util.@Nullable Date> value;\n"},
+	}
+	for _, tt := range tests {
+		fc, err := NewFieldContext(tc, &parser.FieldDef{Name: "value", Type: tt.typeRef})
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, g.GenerateField(fc))
+	}
+
+	fc, err := NewFieldContext(tc, &parser.FieldDef{Name: "name", Type: &parser.TypeRef{Name: "String"}})
+	require.NoError(t, err)
+	g.GenerateField(fc)
+	assert.Contains(t, g.GenerateGetter(fc), "public @Nullable String getName()")
+	assert.Contains(t, g.GenerateSetter(fc), "public void setName(@Nullable String name)")
+	assert.True(t, tc.Imports.Has("org.jspecify.annotations.Nullable"))
+	assert.True(t, tc.Imports.Has("org.jspecify.annotations.NonNull"))
+}
+
+func TestFieldGenerator_NullabilityDeclarationAnnotations(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.NullableHandling = config.NullableAnnotation
+	cfg.Java.Nullability.Library = config.NullabilityJSR305
+	schema := &parser.Schema{Types: map[string]*parser.TypeDef{}}
+	ctx := NewContext(cfg, schema)
+	tc := NewTypeContext(ctx, &parser.TypeDef{Name: "User", Kind: parser.TypeKindObject})
+	g := NewFieldGenerator()
+
+	fc, err := NewFieldContext(tc, &parser.FieldDef{Name: "tags", Type: &parser.TypeRef{Elem: &parser.TypeRef{Name: "String"}}})
+	require.NoError(t, err)
+	assert.Equal(t, "    @Nullable\n    private List<String> tags;\n", g.GenerateField(fc))
+	assert.Equal(t, "    @Nullable\n    public List<String> getTags() {\n        return this.tags;\n    }\n", g.GenerateGetter(fc))
+	assert.Contains(t, g.GenerateSetter(fc), "public void setTags(@Nullable List<String> tags)")
+	assert.True(t, tc.Imports.Has("javax.annotation.Nullable"))
+
+	fc, err = NewFieldContext(tc, &parser.FieldDef{Name: "id", Type: &parser.TypeRef{Name: "ID", NonNull: true}})
+	require.NoError(t, err)
+	assert.Equal(t, "    private String id;\n", g.GenerateField(fc))
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/source-c/go-gql2j/internal/parser"
 )
 
-// GeneratedFile represents a generated Java file.
+// GeneratedFile represents a generated Java file. TypeDef is nil for files
+// that declare no schema type, such as package-info.java, module-info.java
+// and the constants classes.
 type GeneratedFile struct {
 	FileName string
 	Content  string
@@ -20,6 +22,8 @@ type Generator struct {
 	interfaceGen *InterfaceGenerator
 	enumGen      *EnumGenerator
 	unionGen     *UnionGenerator
+	oneOfGen     *OneOfGenerator
+	kotlinGen    *KotlinGenerator
 }
 
 // NewGenerator creates a new generator.
@@ -30,6 +34,8 @@ func NewGenerator(cfg *config.Config) *Generator {
 		interfaceGen: NewInterfaceGenerator(),
 		enumGen:      NewEnumGenerator(),
 		unionGen:     NewUnionGenerator(),
+		oneOfGen:     NewOneOfGenerator(),
+		kotlinGen:    NewKotlinGenerator(),
 	}
 }
 
@@ -51,6 +57,12 @@ func (g *Generator) Generate(schema *parser.Schema) ([]*GeneratedFile, error) {
 		}
 	}
 
+	aggregateFiles, aggregateErrs := g.generateAggregates(ctx)
+	files = append(files, aggregateFiles...)
+	for _, err := range aggregateErrs {
+		errs.Add(err)
+	}
+
 	if errs.HasErrors() {
 		return files, errs.ToError()
 	}
@@ -58,6 +70,31 @@ func (g *Generator) Generate(schema *parser.Schema) ([]*GeneratedFile, error) {
 	return files, nil
 }
 
+// generateAggregates generates the files derived from the whole schema
+// rather than from a single type: the federation entity references and
+// resolver, the constants classes, the projections, the runtime wiring, the
+// controllers, package-info.java and module-info.java. A change to any type
+// may change them.
+func (g *Generator) generateAggregates(ctx *Context) ([]*GeneratedFile, []error) {
+	var files []*GeneratedFile
+	var errs []error
+	add := func(generated []*GeneratedFile, generatedErrs []error) {
+		files = append(files, generated...)
+		errs = append(errs, generatedErrs...)
+	}
+
+	add(g.generateFederationFiles(ctx))
+	add(g.generateConstantsFiles(ctx))
+	add(g.generateProjectionFiles(ctx))
+	if file := g.generateRuntimeWiringFile(ctx); file != nil {
+		files = append(files, file)
+	}
+	add(g.generateControllerFiles(ctx))
+	add(g.generatePackageFiles(ctx))
+
+	return files, errs
+}
+
 // GenerateType generates a Java file for a single type.
 func (g *Generator) GenerateType(schema *parser.Schema, typeName string) (*GeneratedFile, error) {
 	typeDef := schema.GetType(typeName)
@@ -73,26 +110,34 @@ func (g *Generator) generateType(ctx *Context, typeDef *parser.TypeDef) (*Genera
 	var content string
 	var err error
 
-	switch typeDef.Kind {
-	case parser.TypeKindObject, parser.TypeKindInputObject:
-		content, err = g.classGen.Generate(ctx, typeDef)
-
-	case parser.TypeKindInterface:
-		content, err = g.interfaceGen.Generate(ctx, typeDef)
+	// Federation infrastructure types (_Any, FieldSet, ...) are never generated
+	if parser.IsFederationType(typeDef.Name) {
+		return nil, nil
+	}
 
-	case parser.TypeKindEnum:
-		content, err = g.enumGen.Generate(ctx, typeDef)
+	// Types with unresolved naming collisions are not generated
+	if errs := ctx.nameErrors[typeDef.Name]; len(errs) > 0 {
+		collection := errors.NewErrorCollection()
+		for _, err := range errs {
+			collection.Add(err)
+		}
+		return nil, collection.ToError()
+	}
 
-	case parser.TypeKindUnion:
-		// Generate unions as marker interfaces
-		content, err = g.unionGen.Generate(ctx, typeDef)
+	if typeDef.Kind != parser.TypeKindScalar {
+		NewTypeContext(ctx, typeDef).checkType()
+	}
 
-	case parser.TypeKindScalar:
+	switch {
+	case typeDef.Kind == parser.TypeKindScalar:
 		// Scalars are mapped to existing Java types
 		return nil, nil
 
+	case ctx.Config.Target == config.TargetKotlin:
+		content, err = g.kotlinGen.Generate(ctx, typeDef)
+
 	default:
-		return nil, nil
+		content, err = g.generateJava(ctx, typeDef)
 	}
 
 	if err != nil {
@@ -107,6 +152,9 @@ func (g *Generator) generateType(ctx *Context, typeDef *parser.TypeDef) (*Genera
 	// Determine the file name
 	typeName := ctx.NamingHelper.GetTypeName(typeDef)
 	fileName := typeName + ".java"
+	if ctx.Config.Target == config.TargetKotlin {
+		fileName = typeName + ".kt"
+	}
 
 	return &GeneratedFile{
 		FileName: fileName,
@@ -115,11 +163,36 @@ func (g *Generator) generateType(ctx *Context, typeDef *parser.TypeDef) (*Genera
 	}, nil
 }
 
+// generateJava generates the Java source of a type, or "" if the type is
+// skipped.
+func (g *Generator) generateJava(ctx *Context, typeDef *parser.TypeDef) (string, error) {
+	switch typeDef.Kind {
+	case parser.TypeKindObject, parser.TypeKindInputObject:
+		if typeDef.IsOneOf() && ctx.Config.Java.OneOf == config.OneOfSealed {
+			return g.oneOfGen.Generate(ctx, typeDef)
+		}
+		return g.classGen.Generate(ctx, typeDef)
+
+	case parser.TypeKindInterface:
+		return g.interfaceGen.Generate(ctx, typeDef)
+
+	case parser.TypeKindEnum:
+		return g.enumGen.Generate(ctx, typeDef)
+
+	case parser.TypeKindUnion:
+		// Generate unions as marker interfaces
+		return g.unionGen.Generate(ctx, typeDef)
+
+	default:
+		return "", nil
+	}
+}
+
 // Result represents the complete generation result.
 type Result struct {
 	Files    []*GeneratedFile
 	Errors   []error
-	Warnings []string
+	Warnings []error
 }
 
 // GenerateWithResult generates Java files and returns detailed results.
@@ -138,6 +211,11 @@ func (g *Generator) GenerateWithResult(schema *parser.Schema) *Result {
 		}
 	}
 
+	aggregateFiles, aggregateErrs := g.generateAggregates(ctx)
+	result.Files = append(result.Files, aggregateFiles...)
+	result.Errors = append(result.Errors, aggregateErrs...)
+	result.Warnings = ctx.Warnings()
+
 	return result
 }
 
@@ -154,11 +232,15 @@ type Stats struct {
 // GetStats returns statistics about the generated files.
 func GetStats(files []*GeneratedFile, errors []error) Stats {
 	stats := Stats{
-		TotalTypes: len(files),
 		ErrorCount: len(errors),
 	}
 
 	for _, file := range files {
+		// package-info.java, module-info.java and the like declare no type
+		if file.TypeDef == nil {
+			continue
+		}
+		stats.TotalTypes++
 		switch file.TypeDef.Kind {
 		case parser.TypeKindObject, parser.TypeKindInputObject:
 			stats.Classes++
@@ -376,6 +376,7 @@ func TestEscapeJavaKeyword(t *testing.T) {
 	assert.Equal(t, "_class", EscapeJavaKeyword("class"))
 	assert.Equal(t, "_public", EscapeJavaKeyword("public"))
 	assert.Equal(t, "_final", EscapeJavaKeyword("final"))
+	assert.Equal(t, "__", EscapeJavaKeyword("_"))
 
 	// Non-keywords should remain unchanged
 	assert.Equal(t, "name", EscapeJavaKeyword("name"))
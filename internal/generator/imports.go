@@ -5,36 +5,104 @@ import (
 	"strings"
 )
 
-// ImportManager manages Java imports.
+// ImportManager manages Java imports. A type can only be imported if its
+// simple name is not taken by a type of the package or by another imported or
+// java.lang type; such types are referenced by their fully qualified name.
 type ImportManager struct {
-	imports   map[string]bool
-	package_  string
+	imports     map[string]bool
+	package_    string
+	reserved    map[string]bool
+	simpleNames map[string]string
+	kotlin      bool
 }
 
 // NewImportManager creates a new import manager.
 func NewImportManager(pkg string) *ImportManager {
 	return &ImportManager{
-		imports:  make(map[string]bool),
-		package_: pkg,
+		imports:     make(map[string]bool),
+		package_:    pkg,
+		reserved:    make(map[string]bool),
+		simpleNames: make(map[string]string),
 	}
 }
 
-// Add adds an import to the manager.
+// NewKotlinImportManager creates an import manager for Kotlin code, in which
+// the types of the default imports are not imported and import statements
+// have no semicolon.
+func NewKotlinImportManager(pkg string) *ImportManager {
+	m := NewImportManager(pkg)
+	m.kotlin = true
+	return m
+}
+
+// Reserve marks simple names as taken by types of the package, which shadow
+// imported and java.lang types of the same name.
+func (m *ImportManager) Reserve(names ...string) {
+	for _, name := range names {
+		m.reserved[name] = true
+	}
+}
+
+// Add adds an import to the manager. Types of the same package, java.lang
+// types and types whose simple name is taken are not imported.
 func (m *ImportManager) Add(imp string) {
 	if imp == "" {
 		return
 	}
-	// Don't import java.lang types
-	if strings.HasPrefix(imp, "java.lang.") && !strings.Contains(imp[10:], ".") {
-		return
-	}
 	// Don't import types from the same package
 	if m.isSamePackage(imp) {
 		return
 	}
+	simple := simpleName(imp)
+	if simple != "*" {
+		if m.reserved[simple] {
+			return
+		}
+		if other, ok := m.simpleNames[simple]; ok && other != imp {
+			return
+		}
+		m.simpleNames[simple] = imp
+	}
+	// Don't import java.lang types
+	if isJavaLang(imp) || m.kotlin && isKotlinDefault(imp) {
+		return
+	}
 	m.imports[imp] = true
 }
 
+// Resolve adds the imports of a Java type, annotation or other code and
+// returns the code with the simple names of the imports that could not be
+// imported replaced by their fully qualified names, e.g. java.util.Date if
+// the schema has a Date type.
+func (m *ImportManager) Resolve(code string, imports []string) string {
+	for _, imp := range imports {
+		m.Add(imp)
+		if m.mustQualify(imp) {
+			code = qualifyName(code, imp)
+		}
+	}
+	return code
+}
+
+// ResolveAll resolves each code with the imports of all of them.
+func (m *ImportManager) ResolveAll(codes []string, imports []string) []string {
+	result := make([]string, len(codes))
+	for i, code := range codes {
+		result[i] = m.Resolve(code, imports)
+	}
+	return result
+}
+
+// mustQualify returns true if an added import is referenced by its fully
+// qualified name.
+func (m *ImportManager) mustQualify(imp string) bool {
+	simple := simpleName(imp)
+	if imp == "" || simple == "*" || m.isSamePackage(imp) {
+		return false
+	}
+	return m.simpleNames[simple] != imp
+}
+
 // AddAll adds multiple imports.
 func (m *ImportManager) AddAll(imports []string) {
 	for _, imp := range imports {
@@ -87,7 +155,8 @@ func (m *ImportManager) GetGrouped() [][]string {
 	return groups
 }
 
-// GenerateImportBlock generates the import statements.
+// GenerateImportBlock generates the import statements. Kotlin imports are
+// one block in lexicographic order.
 func (m *ImportManager) GenerateImportBlock() string {
 	groups := m.GetGrouped()
 	if len(groups) == 0 {
@@ -95,6 +164,15 @@ func (m *ImportManager) GenerateImportBlock() string {
 	}
 
 	var sb strings.Builder
+	if m.kotlin {
+		for _, imp := range m.GetSorted() {
+			sb.WriteString("import ")
+			sb.WriteString(EscapeKotlinName(imp))
+			sb.WriteString("\n")
+		}
+		return sb.String()
+	}
+
 	for i, group := range groups {
 		for _, imp := range group {
 			sb.WriteString("import ")
@@ -120,6 +198,101 @@ func (m *ImportManager) isSamePackage(imp string) bool {
 	return imp[:lastDot] == m.package_
 }
 
+// isJavaLang returns true for the implicitly imported java.lang types.
+func isJavaLang(imp string) bool {
+	return strings.HasPrefix(imp, "java.lang.") && !strings.Contains(imp[10:], ".")
+}
+
+// kotlinDefaultPackages are the packages Kotlin code imports by default on
+// the JVM, in addition to java.lang.
+var kotlinDefaultPackages = map[string]bool{
+	"kotlin": true, "kotlin.annotation": true, "kotlin.collections": true,
+	"kotlin.comparisons": true, "kotlin.io": true, "kotlin.jvm": true,
+	"kotlin.ranges": true, "kotlin.sequences": true, "kotlin.text": true,
+}
+
+// isKotlinDefault returns true for the types Kotlin imports by default.
+func isKotlinDefault(imp string) bool {
+	lastDot := strings.LastIndex(imp, ".")
+	return lastDot > 0 && kotlinDefaultPackages[imp[:lastDot]]
+}
+
+// simpleName returns the simple name of a fully qualified type name.
+func simpleName(typeName string) string {
+	return typeName[strings.LastIndex(typeName, ".")+1:]
+}
+
+// qualifyName replaces the simple name of typeName in code by typeName,
+// except in string literals and as part of a qualified name.
+func qualifyName(code, typeName string) string {
+	simple := simpleName(typeName)
+
+	var sb strings.Builder
+	inString := false
+	for i := 0; i < len(code); {
+		c := code[i]
+		switch {
+		case inString:
+			if c == '\\' && i+1 < len(code) {
+				sb.WriteString(code[i : i+2])
+				i += 2
+				continue
+			}
+			inString = c != '"'
+		case c == '"':
+			inString = true
+		case isIdentifierStart(c):
+			j := i + 1
+			for j < len(code) && (isIdentifierStart(code[j]) || (code[j] >= '0' && code[j] <= '9')) {
+				j++
+			}
+			word := code[i:j]
+			if word == simple && (i == 0 || code[i-1] != '.') {
+				// Type-use annotations go between package and simple name
+				written := sb.String()
+				if start := typeAnnotationsStart(written); start < len(written) {
+					sb.Reset()
+					sb.WriteString(written[:start])
+					sb.WriteString(typeName[:len(typeName)-len(simple)])
+					sb.WriteString(written[start:])
+				} else {
+					word = typeName
+				}
+			}
+			sb.WriteString(word)
+			i = j
+			continue
+		}
+		sb.WriteByte(c)
+		i++
+	}
+	return sb.String()
+}
+
+// typeAnnotationsStart returns the index of the annotations without arguments
+// at the end of code, such as "@Nullable ", or len(code) if there are none.
+func typeAnnotationsStart(code string) int {
+	start := len(code)
+	for {
+		i := len(strings.TrimRight(code[:start], " "))
+		if i == start {
+			return start
+		}
+		j := i
+		for j > 0 && (isIdentifierStart(code[j-1]) || code[j-1] == '.' || (code[j-1] >= '0' && code[j-1] <= '9')) {
+			j--
+		}
+		if j == i || j == 0 || code[j-1] != '@' {
+			return start
+		}
+		start = j - 1
+	}
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
 func getImportPrefix(imp string) string {
 	// Group by first two package segments
 	parts := strings.SplitN(imp, ".", 3)
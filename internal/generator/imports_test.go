@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportManager_Add(t *testing.T) {
+	m := NewImportManager("com.example")
+	m.Add("java.util.List")
+	m.Add("java.lang.String")
+	m.Add("java.lang.Thread.State")
+	m.Add("com.example.User")
+
+	assert.Equal(t, []string{"java.lang.Thread.State", "java.util.List"}, m.GetSorted())
+}
+
+func TestImportManager_Resolve(t *testing.T) {
+	m := NewImportManager("com.example")
+	m.Reserve("Date", "Object")
+
+	assert.Equal(t, "List<java.util.Date>", m.Resolve("List<Date>", []string{"java.util.List", "java.util.Date"}))
+	assert.Equal(t, "Map<String, java.lang.Object>",
+		m.Resolve("Map<String, Object>", []string{"java.util.Map", "java.lang.String", "java.lang.Object"}))
+	assert.Equal(t, "Date", m.Resolve("Date", nil))
+	assert.Equal(t, []string{"java.util.List", "java.util.Map"}, m.GetSorted())
+}
+
+func TestImportManager_Resolve_ImportedSimpleName(t *testing.T) {
+	m := NewImportManager("com.example")
+
+	assert.Equal(t, "Date", m.Resolve("Date", []string{"java.util.Date"}))
+	assert.Equal(t, "java.sql.Date", m.Resolve("Date", []string{"java.sql.Date"}))
+	assert.Equal(t, "Date", m.Resolve("Date", []string{"java.util.Date"}))
+	assert.Equal(t, "com.example.lang.String", m.Resolve("String", []string{"java.lang.String", "com.example.lang.String"}))
+	assert.Equal(t, []string{"java.util.Date"}, m.GetSorted())
+}
+
+func TestImportManager_Resolve_Annotations(t *testing.T) {
+	m := NewImportManager("com.example")
+	m.Reserve("Data", "Size")
+
+	assert.Equal(t, []string{"@lombok.Data", "@Builder"},
+		m.ResolveAll([]string{"@Data", "@Builder"}, []string{"lombok.Data", "lombok.Builder"}))
+	assert.Equal(t, `@javax.validation.constraints.Size(max = 10, message = "Size")`,
+		m.Resolve(`@Size(max = 10, message = "Size")`, []string{"javax.validation.constraints.Size"}))
+	assert.Equal(t, []string{"lombok.Builder"}, m.GetSorted())
+}
+
+func TestImportManager_Resolve_TypeUseAnnotations(t *testing.T) {
+	m := NewImportManager("com.example")
+	m.Reserve("Date")
+
+	assert.Equal(t, "List<java.util.@Nullable Date>",
+		m.Resolve("List<@Nullable Date>", []string{"java.util.List", "java.util.Date", "org.jspecify.annotations.Nullable"}))
+	assert.Equal(t, "java.util.@NonNull @Nullable Date", m.Resolve("@NonNull @Nullable Date", []string{"java.util.Date"}))
+	assert.Equal(t, "@Size(max = 1) java.util.Date", m.Resolve("@Size(max = 1) Date", []string{"java.util.Date"}))
+}
@@ -0,0 +1,149 @@
+package generator
+
+import (
+	"sort"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// ChangeSet describes which types need to be regenerated after a schema change.
+type ChangeSet struct {
+	// Affected are the types in the new schema whose output may have changed.
+	Affected []string
+
+	// Removed are the types that no longer exist in the new schema.
+	Removed []string
+}
+
+// IsEmpty returns true if nothing needs to be regenerated or removed.
+func (c *ChangeSet) IsEmpty() bool {
+	return len(c.Affected) == 0 && len(c.Removed) == 0
+}
+
+// AffectedTypes compares two versions of a schema and returns the types that
+// must be regenerated with the given configuration. A type is affected if its
+// own definition or Java name changed, or if it references a type that was
+// added, removed or changed, since the Java name of a referenced type appears
+// in the generated code. Every type is affected if the previous schema is nil
+// or if the set of generated type names changed, since they shadow imported
+// and java.lang types of the same name in every file.
+func AffectedTypes(cfg *config.Config, prev, next *parser.Schema) *ChangeSet {
+	changes := &ChangeSet{}
+
+	if prev == nil {
+		changes.Affected = sortedTypeNames(next)
+		return changes
+	}
+
+	prevCtx, nextCtx := NewContext(cfg, prev), NewContext(cfg, next)
+
+	changed := make(map[string]bool)
+	for name, typeDef := range next.Types {
+		prevDef := prev.Types[name]
+		if !typeDef.SameAs(prevDef) ||
+			nextCtx.NamingHelper.GetTypeName(typeDef) != prevCtx.NamingHelper.GetTypeName(prevDef) {
+			changed[name] = true
+		}
+	}
+	for name := range prev.Types {
+		if _, ok := next.Types[name]; !ok {
+			changed[name] = true
+			changes.Removed = append(changes.Removed, name)
+		}
+	}
+	sort.Strings(changes.Removed)
+
+	if !sameNames(prevCtx.javaTypeNames, nextCtx.javaTypeNames) {
+		changes.Affected = sortedTypeNames(next)
+		return changes
+	}
+
+	affected := make(map[string]bool)
+	for name, typeDef := range next.Types {
+		if changed[name] {
+			affected[name] = true
+			continue
+		}
+		for _, ref := range typeDef.ReferencedTypes() {
+			if changed[ref] {
+				affected[name] = true
+				break
+			}
+		}
+	}
+
+	for name := range affected {
+		changes.Affected = append(changes.Affected, name)
+	}
+	sort.Strings(changes.Affected)
+
+	return changes
+}
+
+// sortedTypeNames returns the names of the types of a schema, sorted.
+func sortedTypeNames(schema *parser.Schema) []string {
+	names := make([]string, 0, len(schema.Types))
+	for name := range schema.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sameNames returns true if two lists contain the same names, in any order.
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int)
+	for _, name := range a {
+		counts[name]++
+	}
+	for _, name := range b {
+		if counts[name] == 0 {
+			return false
+		}
+		counts[name]--
+	}
+	return true
+}
+
+// GenerateTypes generates Java files for the named types and, unless no
+// type is named, the files derived from the whole schema, such as the
+// constants classes and projections, which any type change may affect.
+// Names that are not present in the schema are ignored.
+func (g *Generator) GenerateTypes(schema *parser.Schema, typeNames []string) *Result {
+	result := &Result{}
+	ctx := NewContext(g.config, schema)
+
+	for _, name := range typeNames {
+		typeDef := schema.GetType(name)
+		if typeDef == nil {
+			continue
+		}
+		file, err := g.generateType(ctx, typeDef)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+		if file != nil {
+			result.Files = append(result.Files, file)
+		}
+	}
+
+	if len(typeNames) > 0 {
+		aggregateFiles, aggregateErrs := g.generateAggregates(ctx)
+		result.Files = append(result.Files, aggregateFiles...)
+		result.Errors = append(result.Errors, aggregateErrs...)
+	}
+	result.Warnings = ctx.Warnings()
+
+	return result
+}
+
+// IsTypeFile returns true if the file was generated for a type of the
+// schema, rather than derived from the whole schema.
+func IsTypeFile(schema *parser.Schema, file *GeneratedFile) bool {
+	return file.TypeDef != nil && schema.GetType(file.TypeDef.Name) == file.TypeDef
+}
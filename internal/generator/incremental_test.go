@@ -0,0 +1,151 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+func incrementalSchema() *parser.Schema {
+	return &parser.Schema{
+		Types: map[string]*parser.TypeDef{
+			"User": {
+				Name: "User",
+				Kind: parser.TypeKindObject,
+				Fields: []*parser.FieldDef{
+					{Name: "id", Type: &parser.TypeRef{Name: "ID", NonNull: true}},
+					{Name: "role", Type: &parser.TypeRef{Name: "Role"}},
+				},
+			},
+			"Role": {
+				Name: "Role",
+				Kind: parser.TypeKindEnum,
+				EnumValues: []*parser.EnumValueDef{
+					{Name: "ADMIN"},
+				},
+			},
+			"Post": {
+				Name: "Post",
+				Kind: parser.TypeKindObject,
+				Fields: []*parser.FieldDef{
+					{Name: "title", Type: &parser.TypeRef{Name: "String"}},
+				},
+			},
+		},
+	}
+}
+
+func TestAffectedTypes_NoPrevious(t *testing.T) {
+	changes := AffectedTypes(config.DefaultConfig(), nil, incrementalSchema())
+
+	assert.Equal(t, []string{"Post", "Role", "User"}, changes.Affected)
+	assert.Empty(t, changes.Removed)
+}
+
+func TestAffectedTypes_Unchanged(t *testing.T) {
+	prev := incrementalSchema()
+	next := incrementalSchema()
+	// Moving a type within the file must not count as a change
+	next.Types["Post"].Location = &errors.Location{File: "schema.graphql", Line: 42}
+
+	changes := AffectedTypes(config.DefaultConfig(), prev, next)
+
+	assert.True(t, changes.IsEmpty())
+}
+
+func TestAffectedTypes_ChangedTypeAndDependents(t *testing.T) {
+	prev := incrementalSchema()
+	next := incrementalSchema()
+	next.Types["Role"].EnumValues = append(next.Types["Role"].EnumValues, &parser.EnumValueDef{Name: "GUEST"})
+
+	changes := AffectedTypes(config.DefaultConfig(), prev, next)
+
+	// User references Role, which changed
+	assert.Equal(t, []string{"Role", "User"}, changes.Affected)
+	assert.Empty(t, changes.Removed)
+}
+
+func TestAffectedTypes_RemovedType(t *testing.T) {
+	prev := incrementalSchema()
+	next := incrementalSchema()
+	delete(next.Types, "Post")
+
+	changes := AffectedTypes(config.DefaultConfig(), prev, next)
+
+	// Post no longer shadows imports named Post in the other files
+	assert.Equal(t, []string{"Role", "User"}, changes.Affected)
+	assert.Equal(t, []string{"Post"}, changes.Removed)
+}
+
+func TestAffectedTypes_RenamedType(t *testing.T) {
+	prev := incrementalSchema()
+	next := incrementalSchema()
+	next.Types["Role"].Directives = []*parser.DirectiveDef{
+		{Name: "javaName", Arguments: map[string]interface{}{"name": "Date"}},
+	}
+
+	changes := AffectedTypes(config.DefaultConfig(), prev, next)
+
+	// Date now shadows java.util.Date, which any type may import
+	assert.Equal(t, []string{"Post", "Role", "User"}, changes.Affected)
+	assert.Empty(t, changes.Removed)
+}
+
+func TestAffectedTypes_CollisionSuffix(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.Naming.Collisions = config.CollisionsSuffix
+	cfg.Java.Naming.ClassSuffix = "Type"
+
+	prev := incrementalSchema()
+	prev.Types["PostType"] = &parser.TypeDef{Name: "PostType", Kind: parser.TypeKindEnum, EnumValues: []*parser.EnumValueDef{{Name: "ARTICLE"}}}
+	next := incrementalSchema()
+	next.Types["PostType"] = prev.Types["PostType"]
+	next.Types["Post"].Directives = []*parser.DirectiveDef{
+		{Name: "javaName", Arguments: map[string]interface{}{"name": "PostType2"}},
+	}
+
+	changes := AffectedTypes(cfg, prev, next)
+
+	// The enum PostType was renamed from PostType2 to PostType
+	assert.Equal(t, []string{"Post", "PostType"}, changes.Affected)
+}
+
+func TestGenerator_GenerateTypes(t *testing.T) {
+	cfg := config.DefaultConfig()
+	gen := NewGenerator(cfg)
+
+	result := gen.GenerateTypes(incrementalSchema(), []string{"User", "Missing"})
+
+	require.Empty(t, result.Errors)
+	require.Len(t, result.Files, 1)
+	assert.Equal(t, "User.java", result.Files[0].FileName)
+}
+
+func TestGenerator_GenerateTypes_Aggregates(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Constants = config.ConstantsConfig{Enabled: true, Style: config.ConstantsSeparate}
+	cfg.Output.PackageInfo.Enabled = true
+	gen := NewGenerator(cfg)
+	schema := incrementalSchema()
+
+	result := gen.GenerateTypes(schema, []string{"Role"})
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+	assert.Contains(t, files, "Role.java")
+	assert.NotContains(t, files, "User.java")
+	// Derived files are regenerated on any change
+	assert.Contains(t, files, "UserFields.java")
+	assert.Contains(t, files, "PostFields.java")
+	assert.Contains(t, files, PackageInfoFileName)
+
+	for _, file := range result.Files {
+		assert.Equal(t, file.FileName == "Role.java", IsTypeFile(schema, file), file.FileName)
+	}
+
+	assert.Empty(t, gen.GenerateTypes(schema, nil).Files)
+}
@@ -62,9 +62,11 @@ func (g *InterfaceGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (st
 			if i > 0 {
 				sb.WriteString(", ")
 			}
-			// Apply interface naming convention
+			// Apply interface naming convention and renames
 			ifaceName := iface
-			if ctx.Config.Java.Naming.InterfacePrefix != "" {
+			if ifaceDef := ctx.Schema.GetType(iface); ifaceDef != nil {
+				ifaceName = ctx.NamingHelper.GetTypeName(ifaceDef)
+			} else if ctx.Config.Java.Naming.InterfacePrefix != "" {
 				ifaceName = ctx.Config.Java.Naming.InterfacePrefix + iface
 			}
 			sb.WriteString(ifaceName)
@@ -73,6 +75,9 @@ func (g *InterfaceGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (st
 
 	sb.WriteString(" {\n\n")
 
+	// Constants of the GraphQL names of the type and its fields
+	sb.WriteString(generateNestedConstants(tc, true))
+
 	// Generate method declarations
 	for _, field := range typeDef.Fields {
 		methodCode, err := g.fieldGen.GenerateInterfaceMethod(tc, field)
@@ -80,7 +85,7 @@ func (g *InterfaceGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (st
 			return "", errors.NewGenerateError(
 				"failed to generate interface method",
 				err,
-			).WithTypeName(typeDef.Name).WithFieldName(field.Name)
+			).WithTypeName(typeDef.Name).WithFieldName(field.Name).WithLocation(field.Location)
 		}
 		if methodCode != "" {
 			sb.WriteString(methodCode)
@@ -109,14 +114,13 @@ func (g *InterfaceGenerator) generateInterfaceAnnotations(tc *TypeContext) []str
 	var annotations []string
 
 	// Deprecated annotation
-	if deprecated, _ := tc.CustomAnnotation.GenerateDeprecatedAnnotation(tc.TypeDef.Directives); deprecated != "" {
-		annotations = append(annotations, deprecated)
+	if deprecated, deprecatedImport := tc.CustomAnnotation.GenerateDeprecatedAnnotation(tc.TypeDef.Directives); deprecated != "" {
+		annotations = append(annotations, tc.Imports.Resolve(deprecated, []string{deprecatedImport}))
 	}
 
 	// Custom annotations (no Lombok for interfaces typically)
 	customAnns, customImports := tc.CustomAnnotation.GenerateTypeAnnotations(tc.TypeDef)
-	annotations = append(annotations, customAnns...)
-	tc.Imports.AddAll(customImports)
+	annotations = append(annotations, tc.Imports.ResolveAll(customAnns, customImports)...)
 
 	return annotations
 }
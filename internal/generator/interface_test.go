@@ -306,3 +306,17 @@ func TestInterfaceGenerator_Generate_WithJavaName(t *testing.T) {
 
 	assert.Contains(t, content, "public interface INode")
 }
+
+func TestInterfaceGenerator_NullabilityAnnotations(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.NullableHandling = config.NullableAnnotation
+	cfg.Java.Nullability.Library = config.NullabilityAndroid
+	schema := &parser.Schema{Types: map[string]*parser.TypeDef{}}
+	ctx := NewContext(cfg, schema)
+	tc := NewTypeContext(ctx, &parser.TypeDef{Name: "Node", Kind: parser.TypeKindInterface})
+
+	method, err := NewFieldGenerator().GenerateInterfaceMethod(tc, &parser.FieldDef{Name: "name", Type: &parser.TypeRef{Name: "String"}})
+	require.NoError(t, err)
+	assert.Equal(t, "    @Nullable\n    String getName();\n", method)
+	assert.True(t, tc.Imports.Has("androidx.annotation.Nullable"))
+}
@@ -0,0 +1,451 @@
+package generator
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// kotlinDefaultDeprecationReason is the message of @Deprecated for
+// @deprecated without a reason, the default reason of GraphQL.
+const kotlinDefaultDeprecationReason = "No longer supported"
+
+// KotlinGenerator generates Kotlin data classes for objects and inputs, enum
+// classes, and sealed interfaces for interfaces and unions.
+type KotlinGenerator struct {
+	fieldGen *FieldGenerator
+}
+
+// NewKotlinGenerator creates a new Kotlin generator.
+func NewKotlinGenerator() *KotlinGenerator {
+	return &KotlinGenerator{
+		fieldGen: NewFieldGenerator(),
+	}
+}
+
+// kotlinProperty is a generated property of a data class or interface.
+type kotlinProperty struct {
+	fc   *FieldContext
+	name string
+	// kotlinType is the type of the property, with ? if it is nullable
+	kotlinType string
+	override   bool
+}
+
+// Generate generates the Kotlin file of a type, or "" if the type is skipped.
+func (g *KotlinGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string, error) {
+	tc := NewTypeContext(ctx, typeDef)
+
+	if tc.ShouldSkip() {
+		return "", nil
+	}
+
+	var body string
+	var err error
+	switch typeDef.Kind {
+	case parser.TypeKindObject, parser.TypeKindInputObject:
+		body, err = g.generateClass(tc)
+	case parser.TypeKindInterface:
+		body, err = g.generateInterface(tc)
+	case parser.TypeKindUnion:
+		body = g.generateHeader(tc) + "sealed interface " + tc.TypeName + "\n"
+	case parser.TypeKindEnum:
+		body = g.generateEnum(tc)
+	default:
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("package ")
+	sb.WriteString(EscapeKotlinName(ctx.Config.Output.Package))
+	sb.WriteString("\n\n")
+
+	if imports := tc.Imports.GenerateImportBlock(); imports != "" {
+		sb.WriteString(imports)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(body)
+
+	return sb.String(), nil
+}
+
+// generateHeader generates the KDoc and the annotations of a type.
+func (g *KotlinGenerator) generateHeader(tc *TypeContext) string {
+	var sb strings.Builder
+
+	if tc.TypeDef.Description != "" {
+		sb.WriteString(g.fieldGen.generateJavadoc(tc.TypeDef.Description, ""))
+	}
+
+	if deprecated := kotlinDeprecated(tc.TypeDef.Directives); deprecated != "" {
+		sb.WriteString(deprecated)
+		sb.WriteString("\n")
+	}
+	customAnns, customImports := tc.CustomAnnotation.GenerateTypeAnnotations(tc.TypeDef)
+	for _, ann := range tc.Imports.ResolveAll(customAnns, customImports) {
+		sb.WriteString(kotlinAnnotation(ann, ""))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// generateClass generates a data class, or a class if no field is generated,
+// since data classes need a property. Nullable properties default to null.
+// A @oneOf input checks that exactly one property is set.
+func (g *KotlinGenerator) generateClass(tc *TypeContext) (string, error) {
+	properties, err := g.properties(tc)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(g.generateHeader(tc))
+
+	if len(properties) == 0 {
+		sb.WriteString("class ")
+		sb.WriteString(tc.TypeName)
+	} else {
+		sb.WriteString("data class ")
+		sb.WriteString(tc.TypeName)
+		sb.WriteString("(\n")
+		for i, p := range properties {
+			sb.WriteString(g.generateProperty(tc, p))
+			if strings.HasSuffix(p.kotlinType, "?") {
+				sb.WriteString(" = null")
+			}
+			if i < len(properties)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(")")
+	}
+
+	if supertypes := g.supertypes(tc); len(supertypes) > 0 {
+		sb.WriteString(" : ")
+		sb.WriteString(strings.Join(supertypes, ", "))
+	}
+
+	if tc.TypeDef.IsOneOf() && len(properties) > 0 {
+		names := make([]string, len(properties))
+		for i, p := range properties {
+			names[i] = EscapeKotlinKeyword(p.name)
+		}
+		sb.WriteString(" {\n")
+		sb.WriteString("    init {\n")
+		sb.WriteString("        require(listOfNotNull(" + strings.Join(names, ", ") + ").size == 1) {\n")
+		sb.WriteString("            \"exactly one field of " + tc.TypeName + " must be set\"\n")
+		sb.WriteString("        }\n")
+		sb.WriteString("    }\n")
+		sb.WriteString("}")
+	}
+	sb.WriteString("\n")
+
+	return sb.String(), nil
+}
+
+// generateInterface generates a sealed interface with an abstract property
+// per field.
+func (g *KotlinGenerator) generateInterface(tc *TypeContext) (string, error) {
+	properties, err := g.properties(tc)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(g.generateHeader(tc))
+	sb.WriteString("sealed interface ")
+	sb.WriteString(tc.TypeName)
+
+	if supertypes := g.supertypes(tc); len(supertypes) > 0 {
+		sb.WriteString(" : ")
+		sb.WriteString(strings.Join(supertypes, ", "))
+	}
+
+	if len(properties) > 0 {
+		sb.WriteString(" {\n")
+		for i, p := range properties {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(g.generateProperty(tc, p))
+			sb.WriteString("\n")
+		}
+		sb.WriteString("}")
+	}
+	sb.WriteString("\n")
+
+	return sb.String(), nil
+}
+
+// generateEnum generates an enum class.
+func (g *KotlinGenerator) generateEnum(tc *TypeContext) string {
+	var sb strings.Builder
+	sb.WriteString(g.generateHeader(tc))
+	sb.WriteString("enum class ")
+	sb.WriteString(tc.TypeName)
+	sb.WriteString(" {\n")
+
+	var enumValues []*parser.EnumValueDef
+	for _, enumValue := range tc.TypeDef.EnumValues {
+		if !tc.ShouldSkipEnumValue(enumValue) {
+			enumValues = append(enumValues, enumValue)
+		}
+	}
+
+	for i, enumValue := range enumValues {
+		if enumValue.Description != "" {
+			sb.WriteString(g.fieldGen.generateJavadoc(enumValue.Description, "    "))
+		}
+
+		if deprecated := kotlinDeprecated(enumValue.Directives); deprecated != "" {
+			sb.WriteString("    " + deprecated + "\n")
+		}
+		valueName := tc.NamingHelper.GetEnumValueName(enumValue)
+		customAnns, customImports := tc.CustomAnnotation.GenerateEnumValueAnnotations(enumValue)
+		if jsonProperty, jacksonImports := tc.JacksonGen.GeneratePropertyAnnotation(enumValue.Name, valueName, customAnns); jsonProperty != "" {
+			sb.WriteString("    " + tc.Imports.Resolve(jsonProperty, jacksonImports) + "\n")
+		}
+		for _, ann := range tc.Imports.ResolveAll(customAnns, customImports) {
+			sb.WriteString("    " + kotlinAnnotation(ann, "") + "\n")
+		}
+
+		sb.WriteString("    ")
+		sb.WriteString(EscapeKotlinKeyword(valueName))
+		if i < len(enumValues)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// properties returns the generated properties of a type with their Kotlin
+// types. Properties declared by an interface of the type override them.
+func (g *KotlinGenerator) properties(tc *TypeContext) ([]*kotlinProperty, error) {
+	inherited := g.inheritedFields(tc.Context, tc.TypeDef, make(map[string]bool))
+
+	var properties []*kotlinProperty
+	for _, field := range tc.TypeDef.Fields {
+		fc, err := NewFieldContext(tc, field)
+		if err != nil {
+			return nil, errors.NewGenerateError("failed to create field context", err).
+				WithTypeName(tc.TypeDef.Name).
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
+		}
+		if fc.ShouldSkip() {
+			continue
+		}
+
+		result, err := tc.TypeMapper.MapKotlinFieldType(field)
+		if err != nil {
+			return nil, errors.NewGenerateError("failed to map field type", err).
+				WithTypeName(tc.TypeDef.Name).
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
+		}
+
+		properties = append(properties, &kotlinProperty{
+			fc:         fc,
+			name:       fc.FieldName,
+			kotlinType: tc.Imports.Resolve(result.JavaType, referencedImports(result.JavaType, result.Imports)),
+			override:   inherited[field.Name],
+		})
+	}
+	return properties, nil
+}
+
+// inheritedFields returns the names of the generated fields of the
+// interfaces a type implements, directly or through other interfaces.
+func (g *KotlinGenerator) inheritedFields(ctx *Context, typeDef *parser.TypeDef, visited map[string]bool) map[string]bool {
+	fields := make(map[string]bool)
+	for _, name := range typeDef.Interfaces {
+		iface := ctx.Schema.GetType(name)
+		if iface == nil || visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		tc := NewTypeContext(ctx, iface)
+		if tc.ShouldSkip() {
+			continue
+		}
+		for _, field := range iface.Fields {
+			if !(&FieldContext{TypeContext: tc, Field: field}).ShouldSkip() {
+				fields[field.Name] = true
+			}
+		}
+		for field := range g.inheritedFields(ctx, iface, visited) {
+			fields[field] = true
+		}
+	}
+	return fields
+}
+
+// supertypes returns the interfaces a type implements and, for objects, the
+// unions it is a member of.
+func (g *KotlinGenerator) supertypes(tc *TypeContext) []string {
+	var supertypes []string
+	for _, iface := range tc.TypeDef.Interfaces {
+		name := iface
+		if ifaceDef := tc.Schema.GetType(iface); ifaceDef != nil {
+			name = tc.NamingHelper.GetTypeName(ifaceDef)
+		}
+		supertypes = append(supertypes, name)
+	}
+
+	if tc.TypeDef.Kind != parser.TypeKindObject {
+		return supertypes
+	}
+	var unions []string
+	for _, t := range tc.Schema.Types {
+		if t.Kind != parser.TypeKindUnion || NewTypeContext(tc.Context, t).ShouldSkip() {
+			continue
+		}
+		for _, member := range t.PossibleTypes {
+			if member == tc.TypeDef.Name {
+				unions = append(unions, tc.NamingHelper.GetTypeName(t))
+			}
+		}
+	}
+	sort.Strings(unions)
+	return append(supertypes, unions...)
+}
+
+// generateProperty generates the declaration of a property with its KDoc
+// and annotations. Constraints and @Valid annotate the backing field, as
+// Bean Validation reads them there.
+func (g *KotlinGenerator) generateProperty(tc *TypeContext, p *kotlinProperty) string {
+	fc := p.fc
+	var sb strings.Builder
+
+	if fc.Field.Description != "" {
+		sb.WriteString(g.fieldGen.generateJavadoc(fc.Field.Description, "    "))
+	}
+
+	var annotations []string
+	if deprecated := kotlinDeprecated(fc.Field.Directives); deprecated != "" {
+		annotations = append(annotations, deprecated)
+	}
+
+	// Interfaces declare abstract properties only
+	if tc.TypeDef.Kind != parser.TypeKindInterface {
+		validationAnns, validationImports := fc.ValidationGen.GenerateFieldAnnotations(fc.Field, fc.IsNonNull)
+		for _, ann := range tc.Imports.ResolveAll(validationAnns, validationImports) {
+			annotations = append(annotations, kotlinAnnotation(ann, "field"))
+		}
+		// Kotlin type arguments carry no annotations, so @Valid on the field
+		// cascades to the elements of lists too
+		if parser.ExtractJavaTypeDirective(fc.Field.Directives) == nil && fc.Field.Type != nil {
+			named := &parser.TypeRef{Name: fc.Field.Type.NamedType()}
+			if valid, validImports := fc.ValidationGen.GenerateCascadeAnnotation(named, fc.TypeMapper.IsGeneratedType); valid != "" {
+				annotations = append(annotations, kotlinAnnotation(tc.Imports.Resolve(valid, validImports), "field"))
+			}
+		}
+
+		customAnns, customImports := fc.CustomAnnotation.GenerateFieldAnnotations(fc.Field)
+		if jsonProperty, jacksonImports := fc.JacksonGen.GeneratePropertyAnnotation(fc.Field.Name, p.name, customAnns); jsonProperty != "" {
+			annotations = append(annotations, tc.Imports.Resolve(jsonProperty, jacksonImports))
+		}
+		for _, ann := range tc.Imports.ResolveAll(customAnns, customImports) {
+			annotations = append(annotations, kotlinAnnotation(ann, ""))
+		}
+	}
+
+	for _, ann := range annotations {
+		sb.WriteString("    ")
+		sb.WriteString(ann)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("    ")
+	if p.override {
+		sb.WriteString("override ")
+	}
+	sb.WriteString("val ")
+	sb.WriteString(EscapeKotlinKeyword(p.name))
+	sb.WriteString(": ")
+	sb.WriteString(p.kotlinType)
+
+	return sb.String()
+}
+
+// kotlinDeprecated returns the @Deprecated annotation of Kotlin, which
+// requires a message, if @deprecated is present, or "".
+func kotlinDeprecated(directives []*parser.DirectiveDef) string {
+	deprecated := parser.ExtractDeprecatedDirective(directives)
+	if deprecated == nil {
+		return ""
+	}
+	reason := deprecated.Reason
+	if reason == "" {
+		reason = kotlinDefaultDeprecationReason
+	}
+	return "@Deprecated(" + kotlinString(reason) + ")"
+}
+
+// kotlinString returns a Kotlin string literal.
+func kotlinString(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + replacer.Replace(s) + `"`
+}
+
+// kotlinAnnotation converts an annotation in Java syntax to Kotlin: arrays
+// in braces become brackets, class literals X.class become X::class, nested
+// annotations lose their @, and $ in strings is escaped. A non-empty target
+// is added as use-site target, e.g. @field:NotNull.
+func kotlinAnnotation(annotation, target string) string {
+	var sb strings.Builder
+	inString := false
+	for i := 0; i < len(annotation); i++ {
+		c := annotation[i]
+		switch {
+		case inString:
+			if c == '\\' && i+1 < len(annotation) {
+				sb.WriteString(annotation[i : i+2])
+				i++
+				continue
+			}
+			if c == '$' {
+				sb.WriteString(`\$`)
+				continue
+			}
+			inString = c != '"'
+		case c == '"':
+			inString = true
+		case c == '{':
+			c = '['
+		case c == '}':
+			c = ']'
+		case c == '@' && i > 0:
+			continue
+		case strings.HasPrefix(annotation[i:], ".class") &&
+			(i+6 == len(annotation) || !isIdentifierStart(annotation[i+6]) && !isDigit(annotation[i+6])):
+			sb.WriteString("::class")
+			i += len(".class") - 1
+			continue
+		}
+		sb.WriteByte(c)
+	}
+
+	result := sb.String()
+	if target != "" && strings.HasPrefix(result, "@") {
+		result = "@" + target + ":" + result[1:]
+	}
+	return result
+}
+
+// isDigit returns true for ASCII digits.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,184 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+const kotlinSchema = `
+directive @skip on OBJECT | INTERFACE | FIELD_DEFINITION
+directive @oneOf on INPUT_OBJECT
+scalar DateTime
+"A node with an ID."
+interface Node { id: ID! }
+type User implements Node {
+  id: ID!
+  name: String
+  tags: [String!]!
+  "Creation time."
+  createdAt: DateTime
+  secret: String @skip
+  old: Int @deprecated
+}
+type Post implements Node { id: ID!, in: Boolean }
+union SearchResult = User | Post
+enum Status { ACTIVE, object }
+input Lookup @oneOf { id: ID, email: String }
+type Empty { secret: String @skip }
+`
+
+func kotlinConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Target = config.TargetKotlin
+	cfg.Output.Package = "com.example.in"
+	cfg.TypeMappings.Scalars = map[string]config.ScalarMapping{
+		"DateTime": {JavaType: "OffsetDateTime", Imports: []string{"java.time.OffsetDateTime"}},
+	}
+	return cfg
+}
+
+func TestKotlinGenerator(t *testing.T) {
+	result := generateNamed(t, kotlinConfig(), kotlinSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.NotContains(t, files, "User.java")
+	assert.Equal(t, "package com.example.`in`\n"+`
+import java.time.OffsetDateTime
+
+data class User(
+    override val id: String,
+    val name: String? = null,
+    val tags: List<String>,
+    /**
+     * Creation time.
+     */
+    val createdAt: OffsetDateTime? = null,
+    @Deprecated("No longer supported")
+    val old: Int? = null
+) : Node, SearchResult
+`, files["User.kt"])
+
+	assert.Equal(t, "package com.example.`in`\n"+`
+/**
+ * A node with an ID.
+ */
+sealed interface Node {
+    val id: String
+}
+`, files["Node.kt"])
+
+	assert.Contains(t, files["Post.kt"], "    val `in`: Boolean? = null\n) : Node, SearchResult\n")
+	assert.Contains(t, files["SearchResult.kt"], "\nsealed interface SearchResult\n")
+	assert.Contains(t, files["Status.kt"], "enum class Status {\n    ACTIVE,\n    `object`\n}\n")
+	assert.Contains(t, files["Empty.kt"], "\nclass Empty\n")
+	assert.Contains(t, files["Lookup.kt"], `data class Lookup(
+    val id: String? = null,
+    val email: String? = null
+) {
+    init {
+        require(listOfNotNull(id, email).size == 1) {
+            "exactly one field of Lookup must be set"
+        }
+    }
+}
+`)
+}
+
+func TestKotlinGenerator_Validation(t *testing.T) {
+	cfg := kotlinConfig()
+	cfg.Features.Validation.Enabled = true
+	result := generateNamed(t, cfg, `
+type User { id: ID!, friends: [User!] }
+`)
+	require.Empty(t, result.Errors)
+
+	assert.Contains(t, fileContents(result)["User.kt"], `import jakarta.validation.Valid
+import jakarta.validation.constraints.NotNull
+
+data class User(
+    @field:NotNull
+    val id: String,
+    @field:Valid
+    val friends: List<User>? = null
+)
+`)
+}
+
+func TestKotlinGenerator_Keywords(t *testing.T) {
+	result := generateNamed(t, kotlinConfig(), `
+directive @oneOf on INPUT_OBJECT
+type Query { fun: String, default: String, record: Int, value: String }
+input Pick @oneOf { fun: String, object: Int }
+`)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	// Only Kotlin hard keywords are escaped, with backticks
+	assert.Contains(t, files["Query.kt"], `data class Query(
+    val `+"`fun`"+`: String? = null,
+    val default: String? = null,
+    val record: Int? = null,
+    val value: String? = null
+)
+`)
+	assert.Contains(t, files["Pick.kt"], "        require(listOfNotNull(`fun`, `object`).size == 1) {\n")
+
+	// Names chosen for collisions are based on the Kotlin name
+	cfg := kotlinConfig()
+	cfg.Java.Naming.Collisions = config.CollisionsSuffix
+	result = generateNamed(t, cfg, "type Query { default: String, Default: String }")
+	require.Empty(t, result.Errors)
+	assert.Contains(t, fileContents(result)["Query.kt"], "    val default: String? = null,\n    val default2: String? = null\n")
+}
+
+func TestKotlinGenerator_QualifiedScalars(t *testing.T) {
+	cfg := kotlinConfig()
+	cfg.TypeMappings.Scalars["Money"] = config.ScalarMapping{JavaType: "java.math.BigDecimal", Imports: []string{"java.math.BigDecimal"}}
+	result := generateNamed(t, cfg, `
+scalar Money
+scalar DateTime
+type Order { total: Money, totals: [Money!], createdAt: DateTime }
+`)
+	require.Empty(t, result.Errors)
+	content := fileContents(result)["Order.kt"]
+
+	assert.Contains(t, content, "    val total: java.math.BigDecimal? = null,\n")
+	assert.Contains(t, content, "    val totals: List<java.math.BigDecimal>? = null,\n")
+	assert.NotContains(t, content, "import java.math.BigDecimal")
+	assert.Contains(t, content, "import java.time.OffsetDateTime\n")
+}
+
+func TestKotlinAnnotation(t *testing.T) {
+	tests := []struct {
+		annotation string
+		target     string
+		expected   string
+	}{
+		{"@NotNull", "field", "@field:NotNull"},
+		{`@Size(min = 1, message = "$x")`, "", `@Size(min = 1, message = "\$x")`},
+		{`@JsonSubTypes({@Type(value = A.class, name = "a.class")})`, "", `@JsonSubTypes([Type(value = A::class, name = "a.class")])`},
+		{"@Audited(classifier = X.classifier)", "", "@Audited(classifier = X.classifier)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.annotation, func(t *testing.T) {
+			assert.Equal(t, tt.expected, kotlinAnnotation(tt.annotation, tt.target))
+		})
+	}
+}
+
+func TestImportManager_Kotlin(t *testing.T) {
+	m := NewKotlinImportManager("com.example")
+	m.Add("kotlin.collections.List")
+	m.Add("java.util.UUID")
+	m.Add("jakarta.validation.constraints.NotNull")
+	m.Add("com.example.in.Thing")
+
+	assert.Equal(t, "import com.example.`in`.Thing\nimport jakarta.validation.constraints.NotNull\nimport java.util.UUID\n",
+		m.GenerateImportBlock())
+}
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"sort"
 	"strings"
 	"unicode"
 
@@ -10,16 +11,32 @@ import (
 
 // NamingHelper handles naming conventions.
 type NamingHelper struct {
-	config *config.NamingConfig
+	config   *config.NamingConfig
+	acronyms *acronymPolicy
+
+	// Names assigned to resolve naming collisions
+	typeNames      map[string]string
+	fieldNames     map[*parser.FieldDef]string
+	enumValueNames map[*parser.EnumValueDef]string
 }
 
 // NewNamingHelper creates a new naming helper.
 func NewNamingHelper(cfg *config.NamingConfig) *NamingHelper {
-	return &NamingHelper{config: cfg}
+	return &NamingHelper{
+		config:         cfg,
+		acronyms:       newAcronymPolicy(cfg.AcronymCase, cfg.Acronyms),
+		typeNames:      make(map[string]string),
+		fieldNames:     make(map[*parser.FieldDef]string),
+		enumValueNames: make(map[*parser.EnumValueDef]string),
+	}
 }
 
 // GetTypeName returns the Java type name for a type definition.
 func (n *NamingHelper) GetTypeName(typeDef *parser.TypeDef) string {
+	if name, ok := n.typeNames[typeDef.Name]; ok {
+		return name
+	}
+
 	// Check for @javaName directive
 	if javaName := parser.ExtractJavaNameDirective(typeDef.Directives); javaName != nil {
 		return javaName.Name
@@ -44,6 +61,10 @@ func (n *NamingHelper) GetTypeName(typeDef *parser.TypeDef) string {
 
 // GetFieldName returns the Java field name for a field definition.
 func (n *NamingHelper) GetFieldName(field *parser.FieldDef) string {
+	if name, ok := n.fieldNames[field]; ok {
+		return name
+	}
+
 	// Check for @javaName directive
 	if javaName := parser.ExtractJavaNameDirective(field.Directives); javaName != nil {
 		return javaName.Name
@@ -54,11 +75,11 @@ func (n *NamingHelper) GetFieldName(field *parser.FieldDef) string {
 	// Apply naming convention
 	switch n.config.FieldCase {
 	case config.FieldCaseSnake:
-		name = toSnakeCase(name)
+		name = n.acronyms.snakeCase(name)
 	case config.FieldCaseCamel:
 		fallthrough
 	default:
-		name = toCamelCase(name)
+		name = n.acronyms.camelCase(name)
 	}
 
 	return name
@@ -66,13 +87,24 @@ func (n *NamingHelper) GetFieldName(field *parser.FieldDef) string {
 
 // GetEnumValueName returns the Java enum value name.
 func (n *NamingHelper) GetEnumValueName(enumValue *parser.EnumValueDef) string {
+	if name, ok := n.enumValueNames[enumValue]; ok {
+		return name
+	}
+
 	// Check for @javaName directive
 	if javaName := parser.ExtractJavaNameDirective(enumValue.Directives); javaName != nil {
 		return javaName.Name
 	}
 
-	// Enum values are typically uppercase in Java
-	return enumValue.Name
+	switch n.config.EnumValueCase {
+	case config.EnumValueCaseUpperSnake:
+		return n.acronyms.upperSnakeCase(enumValue.Name)
+	case config.EnumValueCasePascal:
+		return n.acronyms.pascalCase(enumValue.Name)
+	default:
+		// Enum values are typically uppercase in Java
+		return enumValue.Name
+	}
 }
 
 // GetGetterName returns the getter method name for a field.
@@ -92,6 +124,21 @@ func (n *NamingHelper) GetSetterName(fieldName string) string {
 	return "set" + capitalizeFirst(fieldName)
 }
 
+// lombokAccessorNames returns the getter and setter names Lombok generates
+// for a field. Primitive boolean fields get an "is" getter, and a leading
+// "is" is dropped from their name.
+func (n *NamingHelper) lombokAccessorNames(fieldName string, primitiveBoolean bool) (string, string) {
+	if !primitiveBoolean {
+		return "get" + capitalizeFirst(fieldName), "set" + capitalizeFirst(fieldName)
+	}
+
+	base := fieldName
+	if r := []rune(fieldName); len(r) > 2 && strings.HasPrefix(fieldName, "is") && unicode.IsUpper(r[2]) {
+		base = string(r[2:])
+	}
+	return "is" + capitalizeFirst(base), "set" + capitalizeFirst(base)
+}
+
 // capitalizeFirst capitalizes the first letter of a string.
 func capitalizeFirst(s string) string {
 	if s == "" {
@@ -102,6 +149,213 @@ func capitalizeFirst(s string) string {
 	return string(r)
 }
 
+// defaultAcronyms are recognized in names in addition to the configured
+// acronyms. Other words of two or more upper case letters are acronyms too.
+var defaultAcronyms = []string{
+	"API", "CPU", "CSS", "CSV", "DB", "DNS", "HTML", "HTTP", "HTTPS", "ID",
+	"IP", "JSON", "JWT", "PDF", "SQL", "SSO", "TCP", "TTL", "UDP", "UI",
+	"URI", "URL", "UTC", "UUID", "XML",
+}
+
+// defaultAcronymPolicy converts names when no naming configuration applies.
+var defaultAcronymPolicy = newAcronymPolicy(config.AcronymCasePreserve, nil)
+
+// acronymPolicy splits names into words and writes their acronyms as
+// configured.
+type acronymPolicy struct {
+	acronymCase string
+	// known maps upper case acronyms to their configured spelling
+	known map[string]string
+	// mixed are acronyms with lower case letters, e.g. OAuth, longest first
+	mixed [][]rune
+}
+
+// newAcronymPolicy creates an acronym policy with the default acronyms and
+// the given ones.
+func newAcronymPolicy(acronymCase string, acronyms []string) *acronymPolicy {
+	p := &acronymPolicy{
+		acronymCase: acronymCase,
+		known:       make(map[string]string),
+	}
+	for _, acronym := range append(append([]string(nil), defaultAcronyms...), acronyms...) {
+		p.known[strings.ToUpper(acronym)] = acronym
+		if strings.ToUpper(acronym) != acronym {
+			p.mixed = append(p.mixed, []rune(acronym))
+		}
+	}
+	sort.SliceStable(p.mixed, func(i, j int) bool { return len(p.mixed[i]) > len(p.mixed[j]) })
+	return p
+}
+
+// splitWords splits a name into words at underscores, hyphens and case
+// changes: user_name and userName are user, name; URLPath is URL, Path;
+// userIDs is user, IDs. Digits belong to the preceding word, e.g. HTTP2Config
+// is HTTP2, Config, and configured mixed case acronyms are words of their
+// own, e.g. OAuth2Token is OAuth2, Token.
+func (p *acronymPolicy) splitWords(s string) []string {
+	var words []string
+	separator := func(r rune) bool { return r == '_' || r == '-' || unicode.IsSpace(r) }
+	for _, chunk := range strings.FieldsFunc(s, separator) {
+		r := []rune(chunk)
+		start := 0
+		for i := 0; i < len(r); i++ {
+			if i == start {
+				if n := p.mixedAcronymAt(r[i:]); n > 0 {
+					i += n
+					for i < len(r) && unicode.IsDigit(r[i]) {
+						i++
+					}
+					words = append(words, string(r[start:i]))
+					start = i
+					i--
+				}
+				continue
+			}
+			if isWordStart(r, i) {
+				words = append(words, string(r[start:i]))
+				start = i
+				i--
+			}
+		}
+		if start < len(r) {
+			words = append(words, string(r[start:]))
+		}
+	}
+	return words
+}
+
+// mixedAcronymAt returns the length of the mixed case acronym r starts with,
+// or 0.
+func (p *acronymPolicy) mixedAcronymAt(r []rune) int {
+	for _, acronym := range p.mixed {
+		if len(r) < len(acronym) || string(r[:len(acronym)]) != string(acronym) {
+			continue
+		}
+		if len(r) == len(acronym) || !unicode.IsLower(r[len(acronym)]) {
+			return len(acronym)
+		}
+	}
+	return 0
+}
+
+// isWordStart returns true if a new word starts at r[i]: an upper case
+// letter after a lower case letter or digit, or the last upper case letter
+// of an acronym followed by a lower case letter, except for a plural s.
+func isWordStart(r []rune, i int) bool {
+	if !unicode.IsUpper(r[i]) {
+		return false
+	}
+	prev := r[i-1]
+	if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+		return true
+	}
+	if !unicode.IsUpper(prev) || i+1 >= len(r) || !unicode.IsLower(r[i+1]) {
+		return false
+	}
+	plural := r[i+1] == 's' && (i+2 == len(r) || !unicode.IsLower(r[i+2]))
+	return !plural
+}
+
+// acronymParts splits a word into its letters and a suffix of trailing
+// digits or a plural s: IDs is ID, s; HTTP2 is HTTP, 2.
+func acronymParts(word string) (string, string) {
+	base := strings.TrimRightFunc(word, unicode.IsDigit)
+	if r := []rune(base); len(r) > 2 && r[len(r)-1] == 's' && !hasLower(string(r[:len(r)-1])) {
+		base = string(r[:len(r)-1])
+	}
+	return base, word[len(base):]
+}
+
+// isKnownAcronym returns true if a word is a known acronym in any case.
+func (p *acronymPolicy) isKnownAcronym(word string) bool {
+	base, _ := acronymParts(word)
+	_, ok := p.known[strings.ToUpper(base)]
+	return ok
+}
+
+// isAcronym returns true if a word is a known acronym in any case, or has
+// two or more letters, all upper case. In names without lower case letters,
+// e.g. USER_ID, only known acronyms are recognized, and only by the upper
+// policy.
+func (p *acronymPolicy) isAcronym(word string, upperName bool) bool {
+	if upperName {
+		return p.acronymCase == config.AcronymCaseUpper && p.isKnownAcronym(word)
+	}
+	base, _ := acronymParts(word)
+	return p.isKnownAcronym(word) || (len([]rune(base)) > 1 && !hasLower(base))
+}
+
+// titleWord writes a word that is not the first of a camelCase name.
+func (p *acronymPolicy) titleWord(word string, upperName bool) string {
+	if p.acronymCase != config.AcronymCaseUpper || !p.isAcronym(word, upperName) {
+		return capitalizeFirst(strings.ToLower(word))
+	}
+
+	base, suffix := acronymParts(word)
+	if spelling, ok := p.known[strings.ToUpper(base)]; ok {
+		return spelling + suffix
+	}
+	return strings.ToUpper(base) + suffix
+}
+
+// preserves returns true for the preserve policy, which converts names as
+// toCamelCase, toSnakeCase and ToPascalCase do, without splitting acronyms
+// off.
+func (p *acronymPolicy) preserves() bool {
+	return p.acronymCase != config.AcronymCaseCamel && p.acronymCase != config.AcronymCaseUpper
+}
+
+// camelCase converts a name to camelCase.
+func (p *acronymPolicy) camelCase(s string) string {
+	if p.preserves() {
+		return toCamelCase(s)
+	}
+	words := p.splitWords(s)
+	upperName := !hasLower(s)
+
+	var sb strings.Builder
+	for i, word := range words {
+		if i == 0 {
+			sb.WriteString(strings.ToLower(word))
+		} else {
+			sb.WriteString(p.titleWord(word, upperName))
+		}
+	}
+	return sb.String()
+}
+
+// pascalCase converts a name to PascalCase.
+func (p *acronymPolicy) pascalCase(s string) string {
+	if p.preserves() {
+		return ToPascalCase(s)
+	}
+	upperName := !hasLower(s)
+
+	var sb strings.Builder
+	for _, word := range p.splitWords(s) {
+		sb.WriteString(p.titleWord(word, upperName))
+	}
+	return sb.String()
+}
+
+// snakeCase converts a name to snake_case.
+func (p *acronymPolicy) snakeCase(s string) string {
+	if p.preserves() {
+		return toSnakeCase(s)
+	}
+	return strings.ToLower(strings.Join(p.splitWords(s), "_"))
+}
+
+// upperSnakeCase converts a name to UPPER_SNAKE_CASE.
+func (p *acronymPolicy) upperSnakeCase(s string) string {
+	return strings.ToUpper(strings.Join(p.splitWords(s), "_"))
+}
+
+// hasLower returns true if s contains a lower case letter.
+func hasLower(s string) bool {
+	return strings.IndexFunc(s, unicode.IsLower) >= 0
+}
+
 // toCamelCase converts a string to camelCase.
 func toCamelCase(s string) string {
 	if s == "" {
@@ -185,7 +439,7 @@ func IsJavaKeyword(word string) bool {
 		"throws": true, "transient": true, "try": true, "void": true,
 		"volatile": true, "while": true, "true": true, "false": true,
 		"null": true, "var": true, "yield": true, "record": true,
-		"sealed": true, "permits": true, "non-sealed": true,
+		"sealed": true, "permits": true, "non-sealed": true, "_": true,
 	}
 	return keywords[word]
 }
@@ -197,3 +451,36 @@ func EscapeJavaKeyword(name string) string {
 	}
 	return name
 }
+
+// IsKotlinKeyword checks if a word is a Kotlin hard keyword, which cannot be
+// used as an identifier.
+func IsKotlinKeyword(word string) bool {
+	keywords := map[string]bool{
+		"as": true, "break": true, "class": true, "continue": true,
+		"do": true, "else": true, "false": true, "for": true,
+		"fun": true, "if": true, "in": true, "interface": true,
+		"is": true, "null": true, "object": true, "package": true,
+		"return": true, "super": true, "this": true, "throw": true,
+		"true": true, "try": true, "typealias": true, "typeof": true,
+		"val": true, "var": true, "when": true, "while": true,
+	}
+	return keywords[word]
+}
+
+// EscapeKotlinKeyword escapes a Kotlin keyword by quoting it in backticks.
+func EscapeKotlinKeyword(name string) string {
+	if IsKotlinKeyword(name) {
+		return "`" + name + "`"
+	}
+	return name
+}
+
+// EscapeKotlinName escapes the Kotlin keywords in a qualified name, such as
+// a package name.
+func EscapeKotlinName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = EscapeKotlinKeyword(part)
+	}
+	return strings.Join(parts, ".")
+}
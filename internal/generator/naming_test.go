@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+func TestAcronymPolicy_SplitWords(t *testing.T) {
+	p := newAcronymPolicy(config.AcronymCasePreserve, []string{"OAuth"})
+
+	tests := map[string][]string{
+		"userName":           {"user", "Name"},
+		"user_name":          {"user", "name"},
+		"USER_NAME":          {"USER", "NAME"},
+		"userID":             {"user", "ID"},
+		"userIDs":            {"user", "IDs"},
+		"URLPath":            {"URL", "Path"},
+		"HTTP2Config":        {"HTTP2", "Config"},
+		"address2Line":       {"address2", "Line"},
+		"OAuth2Token":        {"OAuth2", "Token"},
+		"userOAuthToken":     {"user", "OAuth", "Token"},
+		"überStraßeÄnderung": {"über", "Straße", "Änderung"},
+		"_id":                {"id"},
+	}
+	for input, words := range tests {
+		assert.Equal(t, words, p.splitWords(input), input)
+	}
+}
+
+func TestAcronymPolicy_CamelCase(t *testing.T) {
+	tests := []struct {
+		input                  string
+		preserve, camel, upper string
+	}{
+		{"userName", "userName", "userName", "userName"},
+		{"user_name", "userName", "userName", "userName"},
+		{"USER_NAME", "userName", "userName", "userName"},
+		{"userID", "userID", "userId", "userID"},
+		{"userId", "userId", "userId", "userID"},
+		{"user_id", "userId", "userId", "userID"},
+		{"USER_ID", "userId", "userId", "userID"},
+		{"userIDs", "userIDs", "userIds", "userIDs"},
+		{"URLPath", "uRLPath", "urlPath", "urlPath"},
+		{"pageURL", "pageURL", "pageUrl", "pageURL"},
+		{"HTTP2Config", "hTTP2Config", "http2Config", "http2Config"},
+		{"useHTTP2", "useHTTP2", "useHttp2", "useHTTP2"},
+		{"oauthToken", "oauthToken", "oauthToken", "oauthToken"},
+		{"userOauthToken", "userOauthToken", "userOauthToken", "userOAuthToken"},
+		{"Ärger_grund", "ärgerGrund", "ärgerGrund", "ärgerGrund"},
+	}
+
+	acronyms := []string{"OAuth"}
+	preserve := newAcronymPolicy(config.AcronymCasePreserve, acronyms)
+	camel := newAcronymPolicy(config.AcronymCaseCamel, acronyms)
+	upper := newAcronymPolicy(config.AcronymCaseUpper, acronyms)
+	for _, tt := range tests {
+		assert.Equal(t, tt.preserve, preserve.camelCase(tt.input), "preserve "+tt.input)
+		assert.Equal(t, tt.camel, camel.camelCase(tt.input), "camel "+tt.input)
+		assert.Equal(t, tt.upper, upper.camelCase(tt.input), "upper "+tt.input)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	// The conversions of the preserve policy are those of earlier versions
+	assert.Equal(t, "userId", toCamelCase("user_ID"))
+	assert.Equal(t, "uRLPath", toCamelCase("URLPath"))
+	assert.Equal(t, "iD", toCamelCase("ID"))
+	assert.Equal(t, "Class", toCamelCase("_class"))
+	assert.Equal(t, "userName", toCamelCase("UserName"))
+
+	assert.Equal(t, "user_i_d", toSnakeCase("userID"))
+	assert.Equal(t, "u_r_l_path", toSnakeCase("URLPath"))
+	assert.Equal(t, "h_t_t_p2_config", toSnakeCase("HTTP2Config"))
+	assert.Equal(t, "user_name", toSnakeCase("user_name"))
+
+	assert.Equal(t, "UserName", ToPascalCase("user_name"))
+	assert.Equal(t, "URLPath", ToPascalCase("URLPath"))
+	assert.Equal(t, "UserID", ToPascalCase("userID"))
+	assert.Equal(t, "UserId", ToPascalCase("USER_ID"))
+}
+
+func TestNamingHelper_GetEnumValueName(t *testing.T) {
+	tests := []struct {
+		input               string
+		keep, upper, pascal string
+	}{
+		{"IN_PROGRESS", "IN_PROGRESS", "IN_PROGRESS", "InProgress"},
+		{"inProgress", "inProgress", "IN_PROGRESS", "InProgress"},
+		{"HTTPError", "HTTPError", "HTTP_ERROR", "HTTPError"},
+		{"v2", "v2", "V2", "V2"},
+	}
+
+	for _, tt := range tests {
+		ev := &parser.EnumValueDef{Name: tt.input}
+		for evc, expected := range map[string]string{
+			config.EnumValueCaseKeep:       tt.keep,
+			config.EnumValueCaseUpperSnake: tt.upper,
+			config.EnumValueCasePascal:     tt.pascal,
+		} {
+			n := NewNamingHelper(&config.NamingConfig{EnumValueCase: evc})
+			assert.Equal(t, expected, n.GetEnumValueName(ev), evc+" "+tt.input)
+		}
+	}
+
+	ev := &parser.EnumValueDef{Name: "HTTP_ERROR"}
+	n := NewNamingHelper(&config.NamingConfig{EnumValueCase: config.EnumValueCasePascal, AcronymCase: config.AcronymCaseUpper})
+	assert.Equal(t, "HTTPError", n.GetEnumValueName(ev))
+}
+
+func TestNamingHelper_GetFieldName_Acronyms(t *testing.T) {
+	field := &parser.FieldDef{Name: "userID"}
+
+	assert.Equal(t, "userID", NewNamingHelper(&config.NamingConfig{}).GetFieldName(field))
+	assert.Equal(t, "userId", NewNamingHelper(&config.NamingConfig{AcronymCase: config.AcronymCaseCamel}).GetFieldName(field))
+	assert.Equal(t, "user_i_d", NewNamingHelper(&config.NamingConfig{FieldCase: config.FieldCaseSnake}).GetFieldName(field))
+	assert.Equal(t, "user_id", NewNamingHelper(&config.NamingConfig{FieldCase: config.FieldCaseSnake, AcronymCase: config.AcronymCaseCamel}).GetFieldName(field))
+	assert.Equal(t, "iD", NewNamingHelper(&config.NamingConfig{}).GetFieldName(&parser.FieldDef{Name: "ID"}))
+}
@@ -0,0 +1,309 @@
+package generator
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+	"github.com/source-c/go-gql2j/internal/typemap"
+)
+
+// OneOfGenerator generates @oneOf input objects, of which exactly one field
+// must be set.
+type OneOfGenerator struct {
+	fieldGen *FieldGenerator
+}
+
+// NewOneOfGenerator creates a new @oneOf input generator.
+func NewOneOfGenerator() *OneOfGenerator {
+	return &OneOfGenerator{
+		fieldGen: NewFieldGenerator(),
+	}
+}
+
+// oneOfVariant is a field of a @oneOf input object.
+type oneOfVariant struct {
+	fc *FieldContext
+	// javaType is the non-null type of the field's value
+	javaType string
+}
+
+// variants returns the generated fields of a @oneOf input object with the
+// types of their values.
+func (g *OneOfGenerator) variants(fields []*FieldContext) ([]*oneOfVariant, error) {
+	variants := make([]*oneOfVariant, 0, len(fields))
+	for _, fc := range fields {
+		javaType, err := g.valueType(fc)
+		if err != nil {
+			return nil, errors.NewGenerateError("failed to map @oneOf field", err).
+				WithTypeName(fc.TypeDef.Name).
+				WithFieldName(fc.Field.Name).
+				WithLocation(fc.Field.Location)
+		}
+		variants = append(variants, &oneOfVariant{fc: fc, javaType: javaType})
+	}
+	return variants, nil
+}
+
+// valueType returns the Java type of a field's value: the type of the field
+// as if it were non-null, e.g. CardInput for Optional<CardInput>.
+func (g *OneOfGenerator) valueType(fc *FieldContext) (string, error) {
+	if fc.Field.Type == nil {
+		return fc.JavaType, nil
+	}
+	field := *fc.Field
+	typeRef := *field.Type
+	typeRef.NonNull = true
+	field.Type = &typeRef
+
+	result, err := fc.TypeMapper.MapFieldType(&field)
+	if err != nil {
+		return "", err
+	}
+	return fc.TypeContext.Imports.Resolve(result.JavaType, result.Imports), nil
+}
+
+// isOptional returns true if the field holds its value in an Optional.
+func (v *oneOfVariant) isOptional() bool {
+	return v.fc.Config.Java.NullableHandling == config.NullableOptional && v.fc.JavaType != v.javaType
+}
+
+// requireNonNull returns the statement rejecting a null value of the field,
+// or "" for primitives.
+func (v *oneOfVariant) requireNonNull(indent string) string {
+	if typemap.IsPrimitive(v.javaType) {
+		return ""
+	}
+	objects := v.fc.TypeContext.Imports.Resolve("Objects", []string{"java.util.Objects"})
+	return indent + objects + ".requireNonNull(" + v.fc.FieldName + ", \"" + v.fc.Field.Name + "\");\n"
+}
+
+// GenerateClassMembers generates the members a @oneOf input class has in
+// addition to its fields and accessors: a factory method per field, and a
+// method checking that exactly one field is set, which Bean Validation calls
+// if validation is enabled.
+func (g *OneOfGenerator) GenerateClassMembers(tc *TypeContext, fields []*FieldContext) (string, error) {
+	variants, err := g.variants(fields)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+
+	local := "result"
+	for _, v := range variants {
+		if v.fc.FieldName == local {
+			local = "instance"
+		}
+	}
+
+	for _, v := range variants {
+		sb.WriteString("    /**\n")
+		sb.WriteString("     * Creates a " + tc.TypeName + " with only " + v.fc.FieldName + " set.\n")
+		sb.WriteString("     */\n")
+		sb.WriteString("    public static " + tc.TypeName + " " + v.fc.FieldName + "(" + v.javaType + " " + v.fc.FieldName + ") {\n")
+		sb.WriteString(v.requireNonNull("        "))
+		sb.WriteString("        " + tc.TypeName + " " + local + " = new " + tc.TypeName + "();\n")
+		value := v.fc.FieldName
+		if v.isOptional() {
+			value = tc.Imports.Resolve("Optional", []string{"java.util.Optional"}) + ".of(" + value + ")"
+		}
+		sb.WriteString("        " + local + "." + v.fc.FieldName + " = " + value + ";\n")
+		sb.WriteString("        return " + local + ";\n")
+		sb.WriteString("    }\n\n")
+	}
+
+	sb.WriteString("    /**\n")
+	sb.WriteString("     * Returns true if exactly one field is set, as @oneOf requires.\n")
+	sb.WriteString("     */\n")
+	if assertTrue, assertImports := tc.ValidationGen.GenerateOneOfAnnotation(tc.TypeName); assertTrue != "" {
+		sb.WriteString("    " + tc.Imports.Resolve(assertTrue, assertImports) + "\n")
+	}
+	if ignore, ignoreImports := tc.JacksonGen.GenerateIgnoreAnnotation(); ignore != "" {
+		sb.WriteString("    " + tc.Imports.Resolve(ignore, ignoreImports) + "\n")
+	}
+	sb.WriteString("    public boolean isExactlyOneFieldSet() {\n")
+	sb.WriteString("        int set = 0;\n")
+	for _, v := range variants {
+		// Primitive fields of @javaType are always set and cannot be checked
+		if typemap.IsPrimitive(v.fc.JavaType) {
+			continue
+		}
+		condition := "this." + v.fc.FieldName + " != null"
+		if v.isOptional() {
+			condition += " && this." + v.fc.FieldName + ".isPresent()"
+		}
+		sb.WriteString("        if (" + condition + ") {\n")
+		sb.WriteString("            set++;\n")
+		sb.WriteString("        }\n")
+	}
+	sb.WriteString("        return set == 1;\n")
+	sb.WriteString("    }\n")
+
+	return sb.String(), nil
+}
+
+// Generate generates a @oneOf input object as a sealed interface with a
+// record per field holding its non-null value, and a factory method per
+// record.
+func (g *OneOfGenerator) Generate(ctx *Context, typeDef *parser.TypeDef) (string, error) {
+	tc := NewTypeContext(ctx, typeDef)
+
+	if tc.ShouldSkip() {
+		return "", nil
+	}
+
+	var fieldContexts []*FieldContext
+	for _, field := range typeDef.Fields {
+		fc, err := NewFieldContext(tc, field)
+		if err != nil {
+			return "", errors.NewGenerateError("failed to create field context", err).
+				WithTypeName(typeDef.Name).
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
+		}
+		if fc.ShouldSkip() {
+			continue
+		}
+		fieldContexts = append(fieldContexts, fc)
+	}
+
+	variants, err := g.variants(fieldContexts)
+	if err != nil {
+		return "", err
+	}
+
+	// Records are declared before the names they shadow are known
+	components := make([]string, len(variants))
+	for i, v := range variants {
+		components[i] = g.recordComponent(v)
+	}
+	recordNames := g.recordNames(tc, variants, components)
+
+	var body strings.Builder
+	for i, v := range variants {
+		if v.fc.Field.Description != "" {
+			body.WriteString(g.fieldGen.generateJavadoc(v.fc.Field.Description, "    "))
+		}
+		body.WriteString("    record " + recordNames[i] + "(" + components[i] + ") implements " + tc.TypeName + " {")
+		if check := v.requireNonNull("            "); check != "" {
+			body.WriteString("\n        public " + recordNames[i] + " {\n")
+			body.WriteString(check)
+			body.WriteString("        }\n    ")
+		}
+		body.WriteString("}\n\n")
+	}
+	for i, v := range variants {
+		body.WriteString("    /**\n")
+		body.WriteString("     * Creates a " + tc.TypeName + " with only " + v.fc.FieldName + " set.\n")
+		body.WriteString("     */\n")
+		body.WriteString("    static " + tc.TypeName + " " + v.fc.FieldName + "(" + v.javaType + " " + v.fc.FieldName + ") {\n")
+		body.WriteString("        return new " + recordNames[i] + "(" + v.fc.FieldName + ");\n")
+		body.WriteString("    }\n")
+		if i < len(variants)-1 {
+			body.WriteString("\n")
+		}
+	}
+
+	var sb strings.Builder
+
+	// Generate package declaration
+	sb.WriteString("package ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n\n")
+
+	var annotations []string
+	if deprecated, deprecatedImport := tc.CustomAnnotation.GenerateDeprecatedAnnotation(typeDef.Directives); deprecated != "" {
+		annotations = append(annotations, tc.Imports.Resolve(deprecated, []string{deprecatedImport}))
+	}
+	subtypes := make([]string, len(recordNames))
+	for i, name := range recordNames {
+		subtypes[i] = tc.TypeName + "." + name
+	}
+	jacksonAnns, jacksonImports := tc.JacksonGen.GenerateDeductionAnnotations(subtypes)
+	annotations = append(annotations, tc.Imports.ResolveAll(jacksonAnns, jacksonImports)...)
+	customAnns, customImports := tc.CustomAnnotation.GenerateTypeAnnotations(typeDef)
+	annotations = append(annotations, tc.Imports.ResolveAll(customAnns, customImports)...)
+
+	if imports := tc.Imports.GenerateImportBlock(); imports != "" {
+		sb.WriteString(imports)
+		sb.WriteString("\n")
+	}
+
+	if typeDef.Description != "" {
+		sb.WriteString(g.fieldGen.generateJavadoc(typeDef.Description, ""))
+	}
+	for _, ann := range annotations {
+		sb.WriteString(ann)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("public sealed interface ")
+	sb.WriteString(tc.TypeName)
+	sb.WriteString(" {\n\n")
+	sb.WriteString(generateNestedConstants(tc, true))
+	sb.WriteString(body.String())
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
+
+// recordComponent generates the component of the record of a field, with
+// the annotations of the field. The value is non-null, so it is validated as
+// a non-null field.
+func (g *OneOfGenerator) recordComponent(v *oneOfVariant) string {
+	fc := v.fc
+	imports := fc.TypeContext.Imports
+
+	var annotations []string
+	if deprecated, deprecatedImport := fc.CustomAnnotation.GenerateDeprecatedAnnotation(fc.Field.Directives); deprecated != "" {
+		annotations = append(annotations, imports.Resolve(deprecated, []string{deprecatedImport}))
+	}
+
+	validationAnns, validationImports := fc.ValidationGen.GenerateFieldAnnotations(fc.Field, true)
+	annotations = append(annotations, imports.ResolveAll(validationAnns, validationImports)...)
+	if parser.ExtractJavaTypeDirective(fc.Field.Directives) == nil {
+		if valid, validImports := fc.ValidationGen.GenerateCascadeAnnotation(fc.Field.Type, fc.TypeMapper.IsGeneratedType); valid != "" {
+			annotations = append(annotations, imports.Resolve(valid, validImports))
+		}
+	}
+
+	customAnns, customImports := fc.CustomAnnotation.GenerateFieldAnnotations(fc.Field)
+	if jsonProperty, jacksonImports := fc.JacksonGen.GeneratePropertyAnnotation(fc.Field.Name, fc.FieldName, customAnns); jsonProperty != "" {
+		annotations = append(annotations, imports.Resolve(jsonProperty, jacksonImports))
+	}
+	annotations = append(annotations, imports.ResolveAll(customAnns, customImports)...)
+
+	annotations = append(annotations, v.javaType+" "+fc.FieldName)
+	return strings.Join(annotations, " ")
+}
+
+// typeNamePattern matches the type names in Java code.
+var typeNamePattern = regexp.MustCompile(`\b[A-Z]\w*`)
+
+// recordNames returns the names of the records of the fields: the field name
+// in PascalCase, with a "Value" suffix if it would shadow a type the
+// interface refers to, as a nested type does.
+func (g *OneOfGenerator) recordNames(tc *TypeContext, variants []*oneOfVariant, components []string) []string {
+	shadowed := map[string]bool{tc.TypeName: true, "Objects": true}
+	for _, name := range tc.javaTypeNames {
+		shadowed[name] = true
+	}
+	for _, component := range components {
+		for _, name := range typeNamePattern.FindAllString(component, -1) {
+			shadowed[name] = true
+		}
+	}
+
+	names := make([]string, len(variants))
+	for i, v := range variants {
+		name := capitalizeFirst(strings.TrimLeft(v.fc.FieldName, "_"))
+		if shadowed[name] {
+			name += "Value"
+		}
+		names[i] = name
+	}
+	return names
+}
@@ -0,0 +1,173 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+const oneOfSchema = `
+input CardInput { number: String! }
+input PaymentInput @oneOf {
+  "A card"
+  card: CardInput
+  iban: String
+  points: Int
+}
+`
+
+func TestOneOfClass(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Validation.Enabled = true
+	cfg.Features.Jackson.Enabled = true
+
+	result := generateNamed(t, cfg, oneOfSchema)
+	require.Empty(t, result.Errors)
+	content := fileContents(result)["PaymentInput.java"]
+
+	assert.Contains(t, content, `    public static PaymentInput card(CardInput card) {
+        Objects.requireNonNull(card, "card");
+        PaymentInput result = new PaymentInput();
+        result.card = card;
+        return result;
+    }`)
+	assert.Contains(t, content, `    public static PaymentInput points(int points) {
+        PaymentInput result = new PaymentInput();
+        result.points = points;
+        return result;
+    }`)
+	assert.Contains(t, content, `    @AssertTrue(message = "exactly one field of PaymentInput must be set")
+    @JsonIgnore
+    public boolean isExactlyOneFieldSet() {
+        int set = 0;
+        if (this.card != null) {
+            set++;
+        }
+        if (this.iban != null) {
+            set++;
+        }
+        if (this.points != null) {
+            set++;
+        }
+        return set == 1;
+    }`)
+	assert.Contains(t, content, "import java.util.Objects;")
+	assert.Contains(t, content, "private Integer points;")
+
+	// Plain inputs are unchanged
+	assert.NotContains(t, fileContents(result)["CardInput.java"], "isExactlyOneFieldSet")
+}
+
+func TestOneOfClass_Optional(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.NullableHandling = config.NullableOptional
+
+	result := generateNamed(t, cfg, oneOfSchema)
+	require.Empty(t, result.Errors)
+	content := fileContents(result)["PaymentInput.java"]
+
+	assert.Contains(t, content, "        result.iban = Optional.of(iban);\n")
+	assert.Contains(t, content, "        if (this.iban != null && this.iban.isPresent()) {\n")
+	assert.Contains(t, content, "    public boolean isExactlyOneFieldSet() {\n")
+	assert.NotContains(t, content, "@AssertTrue")
+}
+
+func TestOneOfClass_LombokConstructors(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Lombok.Enabled = true
+	cfg.Features.Lombok.Data = true
+	cfg.Features.Lombok.AllArgsConstructor = true
+	cfg.Features.Lombok.NoArgsConstructor = false
+
+	result := generateNamed(t, cfg, oneOfSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	// The factory methods call the no-args constructor
+	assert.Contains(t, files["PaymentInput.java"], "        PaymentInput result = new PaymentInput();\n")
+	assert.Contains(t, files["PaymentInput.java"], "\n@NoArgsConstructor\n")
+	assert.Contains(t, files["PaymentInput.java"], "\n@AllArgsConstructor\n")
+	assert.Contains(t, files["PaymentInput.java"], "import lombok.NoArgsConstructor;\n")
+	assert.NotContains(t, files["CardInput.java"], "NoArgsConstructor")
+}
+
+func TestOneOfSealed(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.OneOf = config.OneOfSealed
+	cfg.Features.Validation.Enabled = true
+	cfg.Features.Jackson.Enabled = true
+
+	result := generateNamed(t, cfg, oneOfSchema+`
+input SearchInput @oneOf {
+  string: String
+  cardInput: CardInput
+}
+`)
+	require.Empty(t, result.Errors)
+
+	assert.Equal(t, `package com.example.model;
+
+import com.fasterxml.jackson.annotation.JsonSubTypes;
+import com.fasterxml.jackson.annotation.JsonTypeInfo;
+
+import jakarta.validation.Valid;
+import jakarta.validation.constraints.NotNull;
+
+import java.util.Objects;
+
+@JsonTypeInfo(use = JsonTypeInfo.Id.DEDUCTION)
+@JsonSubTypes({
+    @JsonSubTypes.Type(PaymentInput.Card.class),
+    @JsonSubTypes.Type(PaymentInput.Iban.class),
+    @JsonSubTypes.Type(PaymentInput.Points.class)
+})
+public sealed interface PaymentInput {
+
+    /**
+     * A card
+     */
+    record Card(@NotNull @Valid CardInput card) implements PaymentInput {
+        public Card {
+            Objects.requireNonNull(card, "card");
+        }
+    }
+
+    record Iban(@NotNull String iban) implements PaymentInput {
+        public Iban {
+            Objects.requireNonNull(iban, "iban");
+        }
+    }
+
+    record Points(@NotNull int points) implements PaymentInput {}
+
+    /**
+     * Creates a PaymentInput with only card set.
+     */
+    static PaymentInput card(CardInput card) {
+        return new Card(card);
+    }
+
+    /**
+     * Creates a PaymentInput with only iban set.
+     */
+    static PaymentInput iban(String iban) {
+        return new Iban(iban);
+    }
+
+    /**
+     * Creates a PaymentInput with only points set.
+     */
+    static PaymentInput points(int points) {
+        return new Points(points);
+    }
+}
+`, fileContents(result)["PaymentInput.java"])
+
+	// Records do not shadow the types their components refer to
+	search := fileContents(result)["SearchInput.java"]
+	assert.Contains(t, search, "record StringValue(@NotNull String string) implements SearchInput {")
+	assert.Contains(t, search, "record CardInputValue(@NotNull @Valid CardInput cardInput) implements SearchInput {")
+}
@@ -0,0 +1,253 @@
+package generator
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+)
+
+// File names of the package and module declarations.
+const (
+	PackageInfoFileName = "package-info.java"
+	ModuleInfoFileName  = "module-info.java"
+)
+
+// nonNullByDefaultAnnotations are the package annotations of the supported
+// nullness libraries that make unannotated types non-null.
+var nonNullByDefaultAnnotations = map[string]config.AnnotationConfig{
+	config.NonNullByDefaultJSpecify: {
+		Value:   "@NullMarked",
+		Imports: []string{"org.jspecify.annotations.NullMarked"},
+	},
+	config.NonNullByDefaultJSR305: {
+		Value:   "@ParametersAreNonnullByDefault",
+		Imports: []string{"javax.annotation.ParametersAreNonnullByDefault"},
+	},
+}
+
+// nullabilityModules maps the nullness annotation libraries to their
+// modules. The Android annotations are not a module, as Android does not run
+// modules.
+var nullabilityModules = map[string]string{
+	config.NullabilityJSpecify:  "org.jspecify",
+	config.NullabilityJetBrains: "org.jetbrains.annotations",
+	config.NullabilityJSR305:    "jsr305",
+	config.NullabilityChecker:   "org.checkerframework.checker.qual",
+}
+
+// importModules maps packages of types used by scalar mappings to the
+// modules exporting them.
+var importModules = []struct {
+	prefix string
+	module string
+}{
+	{"java.sql.", "java.sql"},
+	{"javax.xml.", "java.xml"},
+	{"com.fasterxml.jackson.annotation.", "com.fasterxml.jackson.annotation"},
+	{"com.fasterxml.jackson.core.", "com.fasterxml.jackson.core"},
+	{"com.fasterxml.jackson.databind.", "com.fasterxml.jackson.databind"},
+}
+
+// generatePackageFiles generates package-info.java and module-info.java, if
+// enabled in the configuration.
+func (g *Generator) generatePackageFiles(ctx *Context) ([]*GeneratedFile, []error) {
+	var files []*GeneratedFile
+	var errs []error
+
+	if ctx.Config.Output.PackageInfo.Enabled {
+		files = append(files, g.generatePackageInfo(ctx))
+	}
+
+	if ctx.Config.Output.ModuleInfo.Enabled {
+		file, err := g.generateModuleInfo(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			files = append(files, file)
+		}
+	}
+
+	return files, errs
+}
+
+// generatePackageInfo generates package-info.java with the package Javadoc
+// and annotations.
+func (g *Generator) generatePackageInfo(ctx *Context) *GeneratedFile {
+	packageInfo := ctx.Config.Output.PackageInfo
+	imports := ctx.importManager()
+
+	var annotations []config.AnnotationConfig
+	if annotation, ok := nonNullByDefaultAnnotations[packageInfo.NonNullByDefault]; ok {
+		annotations = append(annotations, annotation)
+	}
+	annotations = append(annotations, packageInfo.Annotations...)
+
+	var sb strings.Builder
+	if packageInfo.Description != "" {
+		sb.WriteString("/**\n")
+		for _, line := range strings.Split(packageInfo.Description, "\n") {
+			sb.WriteString(" * ")
+			sb.WriteString(strings.TrimSpace(line))
+			sb.WriteString("\n")
+		}
+		sb.WriteString(" */\n")
+	}
+	for _, annotation := range annotations {
+		sb.WriteString(imports.Resolve(annotation.Value, annotation.Imports))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("package ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n")
+
+	if block := imports.GenerateImportBlock(); block != "" {
+		sb.WriteString("\n")
+		sb.WriteString(block)
+	}
+
+	return &GeneratedFile{
+		FileName: PackageInfoFileName,
+		Content:  sb.String(),
+	}
+}
+
+// generateModuleInfo generates module-info.java, exporting the output
+// package and requiring the modules of the enabled features. Its file name
+// is relative to the output directory.
+func (g *Generator) generateModuleInfo(ctx *Context) (*GeneratedFile, error) {
+	root, ok := ctx.Config.ModuleSourceRoot()
+	if !ok {
+		return nil, errors.NewGenerateError(
+			"cannot place module-info.java: output directory "+ctx.Config.Output.Directory+" does not end in the package path",
+			nil,
+		)
+	}
+	fileName, err := relativePath(ctx.Config.Output.Directory, filepath.Join(root, ModuleInfoFileName))
+	if err != nil {
+		return nil, errors.NewGenerateError("cannot place module-info.java", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("module ")
+	sb.WriteString(ctx.Config.ModuleName())
+	sb.WriteString(" {\n")
+	requires := requiredModules(ctx)
+	for _, module := range requires {
+		sb.WriteString("    requires ")
+		sb.WriteString(module)
+		sb.WriteString(";\n")
+	}
+	if len(requires) > 0 {
+		sb.WriteString("\n")
+	}
+	sb.WriteString("    exports ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n}\n")
+
+	return &GeneratedFile{
+		FileName: fileName,
+		Content:  sb.String(),
+	}, nil
+}
+
+// requiredModules returns the modules the generated code requires, sorted
+// by name. Modules only needed at compile time, such as Lombok and the
+// nullness annotations, are static.
+func requiredModules(ctx *Context) []string {
+	cfg := ctx.Config
+	modules := make(map[string]string)
+	require := func(module string) {
+		name := strings.TrimPrefix(strings.TrimPrefix(module, "static "), "transitive ")
+		// A module required both statically and at run time is required at run time
+		if existing, ok := modules[name]; !ok || strings.HasPrefix(existing, "static ") {
+			modules[name] = module
+		}
+	}
+
+	if cfg.Features.Lombok.Enabled {
+		require("static lombok")
+	}
+	if cfg.Features.Validation.Enabled {
+		if cfg.Features.Validation.Package == config.ValidationJavax {
+			require("java.validation")
+		} else {
+			require("jakarta.validation")
+		}
+	}
+	if cfg.Features.Jackson.Enabled {
+		require("com.fasterxml.jackson.annotation")
+	}
+	if cfg.Features.JPA.Enabled {
+		if cfg.Features.Validation.Package == config.ValidationJavax {
+			require("java.persistence")
+		} else {
+			require("jakarta.persistence")
+		}
+	}
+	if cfg.Features.RuntimeWiring.Enabled {
+		require("com.graphqljava")
+	}
+	if cfg.Features.Controllers.Enabled {
+		require("spring.graphql")
+		if ctx.Schema.GetType(ctx.Schema.SubscriptionType) != nil {
+			require("org.reactivestreams")
+		}
+	}
+	if cfg.Java.NullableHandling == config.NullableAnnotation {
+		library := cfg.Java.Nullability.Library
+		if library == "" {
+			library = config.NullabilityJSpecify
+		}
+		if module, ok := nullabilityModules[library]; ok {
+			require("static " + module)
+		}
+	}
+	if cfg.Output.PackageInfo.Enabled {
+		switch cfg.Output.PackageInfo.NonNullByDefault {
+		case config.NonNullByDefaultJSpecify:
+			require("static org.jspecify")
+		case config.NonNullByDefaultJSR305:
+			require("static jsr305")
+		}
+	}
+	for _, scalar := range cfg.TypeMappings.Scalars {
+		for _, imp := range scalar.Imports {
+			for _, im := range importModules {
+				if strings.HasPrefix(imp, im.prefix) {
+					require(im.module)
+				}
+			}
+		}
+	}
+	for _, module := range cfg.Output.ModuleInfo.Requires {
+		require(module)
+	}
+
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = modules[name]
+	}
+	return result
+}
+
+// relativePath returns target relative to dir.
+func relativePath(dir, target string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	absTarget, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Rel(absDir, absTarget)
+}
@@ -0,0 +1,141 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+func TestPackageInfo(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Output.Package = "com.example.model"
+	cfg.Output.PackageInfo = config.PackageInfoConfig{
+		Enabled:          true,
+		Description:      "GraphQL model.\nGenerated by gql2j.",
+		NonNullByDefault: config.NonNullByDefaultJSpecify,
+		Annotations: []config.AnnotationConfig{
+			{Value: "@Generated(\"gql2j\")", Imports: []string{"javax.annotation.processing.Generated"}},
+		},
+	}
+
+	result := generateNamed(t, cfg, "type Query { id: ID }")
+	require.Empty(t, result.Errors)
+
+	assert.Equal(t, `/**
+ * GraphQL model.
+ * Generated by gql2j.
+ */
+@NullMarked
+@Generated("gql2j")
+package com.example.model;
+
+import javax.annotation.processing.Generated;
+
+import org.jspecify.annotations.NullMarked;
+`, fileContents(result)[PackageInfoFileName])
+
+	stats := GetStats(result.Files, result.Errors)
+	assert.Equal(t, 1, stats.TotalTypes)
+	assert.Equal(t, 1, stats.Classes)
+}
+
+func TestModuleInfo(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Output.Directory = filepath.Join("src", "main", "java", "com", "example", "model")
+	cfg.Output.Package = "com.example.model"
+	cfg.Output.ModuleInfo = config.ModuleInfoConfig{Enabled: true, Requires: []string{"java.sql", "static lombok"}}
+	cfg.Features.Lombok.Enabled = true
+	cfg.Features.Validation.Enabled = true
+	cfg.Features.Jackson.Enabled = true
+	cfg.TypeMappings.Scalars = map[string]config.ScalarMapping{
+		"JSON": {JavaType: "JsonNode", Imports: []string{"com.fasterxml.jackson.databind.JsonNode"}},
+	}
+
+	result := generateNamed(t, cfg, "type Query { id: ID }")
+	require.Empty(t, result.Errors)
+
+	fileName := filepath.Join("..", "..", "..", ModuleInfoFileName)
+	assert.Equal(t, `module com.example.model {
+    requires com.fasterxml.jackson.annotation;
+    requires com.fasterxml.jackson.databind;
+    requires jakarta.validation;
+    requires java.sql;
+    requires static lombok;
+
+    exports com.example.model;
+}
+`, fileContents(result)[fileName])
+}
+
+func TestModuleInfo_Directory(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Output.Directory = "generated"
+	cfg.Output.ModuleInfo = config.ModuleInfoConfig{Enabled: true, Name: "com.example.api"}
+
+	result := generateNamed(t, cfg, "type Query { id: ID }")
+	assert.Contains(t, errorMessages(result.Errors), "cannot place module-info.java: output directory generated does not end in the package path")
+
+	cfg.Output.ModuleInfo.Directory = "module"
+	result = generateNamed(t, cfg, "type Query { id: ID }")
+	require.Empty(t, result.Errors)
+	assert.Equal(t, "module com.example.api {\n    exports com.example.model;\n}\n",
+		fileContents(result)[filepath.Join("..", "module", ModuleInfoFileName)])
+}
+
+func TestModuleInfo_FeatureModules(t *testing.T) {
+	tests := []struct {
+		name      string
+		schema    string
+		configure func(cfg *config.Config)
+		expected  []string
+	}{
+		{"runtime wiring", "", func(cfg *config.Config) {
+			cfg.Features.RuntimeWiring.Enabled = true
+		}, []string{"com.graphqljava"}},
+		{"controllers", "", func(cfg *config.Config) {
+			cfg.Features.Controllers.Enabled = true
+		}, []string{"spring.graphql"}},
+		{"controllers with subscriptions", "type Subscription { ticks: Int! }", func(cfg *config.Config) {
+			cfg.Features.Controllers.Enabled = true
+		}, []string{"org.reactivestreams", "spring.graphql"}},
+		{"jakarta persistence", "", func(cfg *config.Config) {
+			cfg.Features.JPA.Enabled = true
+		}, []string{"jakarta.persistence"}},
+		{"javax persistence", "", func(cfg *config.Config) {
+			cfg.Features.JPA.Enabled = true
+			cfg.Features.Validation.Package = config.ValidationJavax
+		}, []string{"java.persistence"}},
+		{"nullness annotations", "", func(cfg *config.Config) {
+			cfg.Java.NullableHandling = config.NullableAnnotation
+		}, []string{"static org.jspecify"}},
+		{"nullness annotation library", "", func(cfg *config.Config) {
+			cfg.Java.NullableHandling = config.NullableAnnotation
+			cfg.Java.Nullability.Library = config.NullabilityJetBrains
+		}, []string{"static org.jetbrains.annotations"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Output.Package = "com.example.model"
+			cfg.Output.ModuleInfo = config.ModuleInfoConfig{Enabled: true, Directory: "module"}
+			tt.configure(cfg)
+
+			result := generateNamed(t, cfg, "type Query { id: ID }\n"+tt.schema)
+			require.Empty(t, result.Errors)
+
+			var expected strings.Builder
+			expected.WriteString("module com.example.model {\n")
+			for _, module := range tt.expected {
+				expected.WriteString("    requires " + module + ";\n")
+			}
+			expected.WriteString("\n    exports com.example.model;\n}\n")
+			assert.Equal(t, expected.String(), fileContents(result)[filepath.Join("..", "module", ModuleInfoFileName)])
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+const persistenceDirectives = `
+directive @entity(table: String) on OBJECT
+directive @id on FIELD_DEFINITION
+directive @column(name: String, length: Int) on FIELD_DEFINITION
+directive @relation(kind: String!, mappedBy: String) on FIELD_DEFINITION
+directive @transient on FIELD_DEFINITION
+`
+
+const persistenceSchema = persistenceDirectives + `
+type Query { user(id: ID!): User }
+type User @entity(table: "users") {
+  id: ID! @id
+  name: String! @column(length: 100)
+  posts: [Post!]! @relation(kind: "ONE_TO_MANY", mappedBy: "author")
+  score: Int @transient
+}
+type Post @entity {
+  id: ID! @id
+  author: User! @column(name: "author_id")
+}
+`
+
+func persistenceConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Features.JPA.Enabled = true
+	return cfg
+}
+
+func TestPersistence_Entities(t *testing.T) {
+	result := generateNamed(t, persistenceConfig(), persistenceSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.Contains(t, files["User.java"], `import jakarta.persistence.Column;
+import jakarta.persistence.Entity;
+import jakarta.persistence.Id;
+import jakarta.persistence.OneToMany;
+import jakarta.persistence.Table;
+import jakarta.persistence.Transient;
+`)
+	assert.Contains(t, files["User.java"], `@Entity
+@Table(name = "users")
+public class User {
+
+    @Id
+    private String id;
+
+    @Column(length = 100)
+    private String name;
+
+    @OneToMany(mappedBy = "author")
+    private List<Post> posts;
+
+    @Transient
+    private Integer score;
+`)
+	assert.Contains(t, files["Post.java"], `@Entity
+public class Post {
+
+    @Id
+    private String id;
+
+    @ManyToOne
+    @JoinColumn(name = "author_id")
+    private User author;
+`)
+	assert.NotContains(t, files["Query.java"], "persistence")
+}
+
+func TestPersistence_Javax(t *testing.T) {
+	cfg := persistenceConfig()
+	cfg.Features.Validation.Package = config.ValidationJavax
+
+	result := generateNamed(t, cfg, persistenceSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.Contains(t, files["Post.java"], "import javax.persistence.Entity;\n")
+	assert.NotContains(t, files["Post.java"], "jakarta")
+}
+
+func TestPersistence_Disabled(t *testing.T) {
+	result := generateNamed(t, config.DefaultConfig(), persistenceSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.NotContains(t, files["User.java"], "persistence")
+	assert.NotContains(t, files["Post.java"], "@Entity")
+}
+
+func TestPersistence_Warnings(t *testing.T) {
+	warnings := generateWarnings(t, persistenceConfig(), persistenceDirectives+`
+type Query { user: User }
+type User @entity {
+  name: String
+  profile: Profile
+  address: Address @transient
+  posts: [Post] @relation(kind: "MANY_TO_ONE", mappedBy: "author")
+  tags: [String] @relation(kind: "ONE_TO_MANY")
+}
+type Post @entity { id: ID! @id }
+type Profile { bio: String }
+type Address { city: String }
+`)
+
+	assert.ElementsMatch(t, []string{
+		"entity User has no @id field",
+		"field User.profile of entity User references Profile, which is not an entity",
+		"field User.posts: mappedBy does not apply to a many-to-one relationship",
+		"field User.tags has @relation but does not reference an entity",
+	}, warningMessages(warnings))
+}
@@ -0,0 +1,521 @@
+package generator
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+	"github.com/source-c/go-gql2j/internal/typemap"
+)
+
+// ProjectionBaseName is the name of the generated base class of the
+// projections.
+const ProjectionBaseName = "GraphQLProjection"
+
+// projectionSuffix is appended to the Java name of a type to name its
+// projection.
+const projectionSuffix = "Projection"
+
+// projectionMethods are the methods every projection has, which field
+// methods are renamed not to override or overload.
+var projectionMethods = map[string]bool{
+	"root": true, "typename": true, "getVariables": true, "toGraphQL": true,
+	"select": true, "fragment": true, "argument": true, "selection": true, "selectionSet": true,
+	"clone": true, "equals": true, "finalize": true, "getClass": true, "hashCode": true,
+	"notify": true, "notifyAll": true, "toString": true, "wait": true,
+}
+
+// projectionBase is the base class of the projections. A projection collects
+// the rendered selections of its type; the projections of the selections of
+// its fields share the variables of the root projection.
+const projectionBase = `/**
+ * Base class of the generated projections, which select fields of a GraphQL
+ * type and render them as a query, with the arguments of the fields passed as
+ * variables.
+ */
+public abstract class GraphQLProjection {
+
+    private final String operation;
+    private final Map<String, String> variableTypes;
+    private final Map<String, Object> variables;
+    private final List<String> selections = new ArrayList<>();
+
+    /**
+     * Creates a projection rendered as a selection set.
+     */
+    protected GraphQLProjection() {
+        this((String) null);
+    }
+
+    /**
+     * Creates a projection rendered as an operation: query, mutation or
+     * subscription.
+     */
+    protected GraphQLProjection(String operation) {
+        this.operation = operation;
+        this.variableTypes = new LinkedHashMap<>();
+        this.variables = new LinkedHashMap<>();
+    }
+
+    /**
+     * Creates the projection of the selection of a field, which shares the
+     * variables of its parent.
+     */
+    protected GraphQLProjection(GraphQLProjection parent) {
+        this.operation = null;
+        this.variableTypes = parent.variableTypes;
+        this.variables = parent.variables;
+    }
+
+    /**
+     * Returns the values of the variables, by name.
+     */
+    public Map<String, Object> getVariables() {
+        return Collections.unmodifiableMap(variables);
+    }
+
+    /**
+     * Renders the selected fields as an operation with its variable
+     * definitions, or as a selection set if the projection is not of a root
+     * type.
+     */
+    public String toGraphQL() {
+        if (operation == null) {
+            return selectionSet();
+        }
+        StringBuilder sb = new StringBuilder(operation);
+        String separator = "(";
+        for (Map.Entry<String, String> variable : variableTypes.entrySet()) {
+            sb.append(separator).append('$').append(variable.getKey()).append(": ").append(variable.getValue());
+            separator = ", ";
+        }
+        if (!variableTypes.isEmpty()) {
+            sb.append(')');
+        }
+        return sb.append(' ').append(selectionSet()).toString();
+    }
+
+    @Override
+    public String toString() {
+        return toGraphQL();
+    }
+
+    /**
+     * Selects a field with the given arguments. Null arguments are omitted.
+     */
+    protected final void select(String field, String... arguments) {
+        select(field, null, arguments);
+    }
+
+    /**
+     * Selects a field with the given arguments and selection. Null arguments
+     * are omitted.
+     */
+    protected final void select(String field, GraphQLProjection selection, String... arguments) {
+        StringBuilder sb = new StringBuilder(field);
+        String separator = "(";
+        for (String argument : arguments) {
+            if (argument != null) {
+                sb.append(separator).append(argument);
+                separator = ", ";
+            }
+        }
+        if (!separator.equals("(")) {
+            sb.append(')');
+        }
+        if (selection != null) {
+            sb.append(' ').append(selection.selectionSet());
+        }
+        selections.add(sb.toString());
+    }
+
+    /**
+     * Selects fields of a possible type with an inline fragment.
+     */
+    protected final void fragment(String typeName, GraphQLProjection selection) {
+        selections.add("... on " + typeName + " " + selection.selectionSet());
+    }
+
+    /**
+     * Passes an argument in a new variable of the given GraphQL type, and
+     * returns the argument, or null if the value is null.
+     */
+    protected final String argument(String name, String type, Object value) {
+        if (value == null) {
+            return null;
+        }
+        String variable = name;
+        for (int n = 2; variableTypes.containsKey(variable); n++) {
+            variable = name + n;
+        }
+        variableTypes.put(variable, type);
+        variables.put(variable, value);
+        return name + ": $" + variable;
+    }
+
+    /**
+     * Applies a selection to the projection of a field and returns it.
+     */
+    protected static <P extends GraphQLProjection> P selection(P projection, Consumer<P> selection) {
+        selection.accept(projection);
+        return projection;
+    }
+
+    private String selectionSet() {
+        if (selections.isEmpty()) {
+            throw new IllegalStateException("no fields are selected");
+        }
+        StringBuilder sb = new StringBuilder("{");
+        for (String selection : selections) {
+            sb.append(' ').append(selection);
+        }
+        return sb.append(" }").toString();
+    }
+}
+`
+
+// projectionBaseImports are the imports of the base class of the projections.
+var projectionBaseImports = []string{
+	"java.util.ArrayList", "java.util.Collections", "java.util.LinkedHashMap",
+	"java.util.List", "java.util.Map", "java.util.function.Consumer",
+	"java.lang.IllegalStateException", "java.lang.Object", "java.lang.Override",
+	"java.lang.String", "java.lang.StringBuilder",
+}
+
+// hasProjection returns true if a projection is generated for the type:
+// objects, interfaces and unions.
+func hasProjection(typeDef *parser.TypeDef) bool {
+	switch typeDef.Kind {
+	case parser.TypeKindObject, parser.TypeKindInterface, parser.TypeKindUnion:
+		return true
+	}
+	return false
+}
+
+// projectionOperation returns the operation a projection of a root type
+// renders, or "" for other types.
+func projectionOperation(schema *parser.Schema, typeName string) string {
+	switch typeName {
+	case schema.QueryType:
+		return "query"
+	case schema.MutationType:
+		return "mutation"
+	case schema.SubscriptionType:
+		return "subscription"
+	}
+	return ""
+}
+
+// generateProjectionFiles generates the projection of each object, interface
+// and union type and their base class, if enabled in the configuration.
+// Projections that would collide with a generated type are not generated.
+func (g *Generator) generateProjectionFiles(ctx *Context) ([]*GeneratedFile, []error) {
+	if !ctx.Config.Features.Projections.Enabled {
+		return nil, nil
+	}
+
+	// File names may not differ only in case
+	taken := map[string]bool{strings.ToLower(ProjectionBaseName): true}
+	for _, name := range ctx.javaTypeNames {
+		taken[strings.ToLower(name)] = true
+	}
+
+	var types []*parser.TypeDef
+	var errs []error
+	projections := make(map[string]string)
+	for _, typeDef := range ctx.namedTypes() {
+		if !hasProjection(typeDef) || len(ctx.nameErrors[typeDef.Name]) > 0 {
+			continue
+		}
+		name := ctx.NamingHelper.GetTypeName(typeDef) + projectionSuffix
+		if taken[strings.ToLower(name)] {
+			err := errors.NewGenerateError("projection "+name+" collides with a generated type", nil).
+				WithTypeName(typeDef.Name)
+			err.Location = typeDef.Location
+			err.Hint = "use @javaName to choose another name for " + typeDef.Name
+			errs = append(errs, err)
+			continue
+		}
+		taken[strings.ToLower(name)] = true
+		projections[typeDef.Name] = name
+		types = append(types, typeDef)
+	}
+	if len(types) == 0 {
+		return nil, errs
+	}
+
+	files := []*GeneratedFile{g.generateProjectionBase(ctx)}
+	for _, typeDef := range types {
+		file, err := g.generateProjection(ctx, typeDef, projections)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		files = append(files, file)
+	}
+
+	return files, errs
+}
+
+// generateProjectionBase generates the base class of the projections.
+func (g *Generator) generateProjectionBase(ctx *Context) *GeneratedFile {
+	imports := ctx.importManager()
+	body := imports.Resolve(projectionBase, projectionBaseImports)
+
+	var sb strings.Builder
+	sb.WriteString("package ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n\n")
+	if block := imports.GenerateImportBlock(); block != "" {
+		sb.WriteString(block)
+		sb.WriteString("\n")
+	}
+	sb.WriteString(body)
+
+	return &GeneratedFile{
+		FileName: ProjectionBaseName + ".java",
+		Content:  sb.String(),
+	}
+}
+
+// projectionMethod is a method of a projection selecting a field or a
+// possible type.
+type projectionMethod struct {
+	name string
+	// parameters are the Java parameters of the arguments of the field
+	parameters []string
+	// arguments are the calls passing the arguments in variables
+	arguments []string
+	// selection is the projection of the field's type, or "" for leaf fields
+	selection string
+}
+
+// generateProjection generates the projection of a type, with a method per
+// field selecting it, and an on<Type> method per possible type selecting
+// fields with an inline fragment.
+func (g *Generator) generateProjection(ctx *Context, typeDef *parser.TypeDef, projections map[string]string) (*GeneratedFile, error) {
+	tc := NewTypeContext(ctx, typeDef)
+	className := projections[typeDef.Name]
+
+	// Possible types of interfaces and unions
+	var possibleTypes []string
+	if typeDef.Kind == parser.TypeKindUnion {
+		possibleTypes = append(possibleTypes, typeDef.PossibleTypes...)
+	} else if typeDef.Kind == parser.TypeKindInterface {
+		for _, t := range ctx.Schema.Types {
+			for _, name := range t.Interfaces {
+				if name == typeDef.Name {
+					possibleTypes = append(possibleTypes, t.Name)
+				}
+			}
+		}
+		sort.Strings(possibleTypes)
+	}
+
+	used := make(map[string]bool)
+	for name := range projectionMethods {
+		used[name] = true
+	}
+	var fragments []*projectionMethod
+	var fragmentTypes []string
+	for _, name := range possibleTypes {
+		projection, ok := projections[name]
+		if !ok {
+			continue
+		}
+		method := &projectionMethod{
+			name:      "on" + capitalizeFirst(ctx.NamingHelper.GetTypeName(ctx.Schema.GetType(name))),
+			selection: projection,
+		}
+		method.addSelection(tc, nil)
+		used[method.name] = true
+		fragments = append(fragments, method)
+		fragmentTypes = append(fragmentTypes, name)
+	}
+
+	var body strings.Builder
+	for _, field := range typeDef.Fields {
+		fc, err := NewFieldContext(tc, field)
+		if err != nil {
+			return nil, errors.NewGenerateError("failed to create field context", err).
+				WithTypeName(typeDef.Name).
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
+		}
+		if fc.ShouldSkip() {
+			continue
+		}
+
+		method := &projectionMethod{name: fc.FieldName}
+		if target := ctx.Schema.GetType(field.Type.NamedType()); target != nil && hasProjection(target) {
+			projection, ok := projections[target.Name]
+			if !ok {
+				// The type is skipped, and so is the field
+				continue
+			}
+			method.selection = projection
+		}
+		if used[method.name] {
+			method.name += "Field"
+		}
+		for base, n := method.name, 2; used[method.name]; n++ {
+			method.name = base + strconv.Itoa(n)
+		}
+		used[method.name] = true
+
+		if err := g.projectionArguments(tc, field, method); err != nil {
+			return nil, errors.NewGenerateError("failed to map argument type", err).
+				WithTypeName(typeDef.Name).
+				WithFieldName(field.Name).
+				WithLocation(field.Location)
+		}
+
+		if field.Description != "" {
+			body.WriteString(g.classGen.fieldGen.generateJavadoc(field.Description, "    "))
+		}
+		if deprecated, deprecatedImport := tc.CustomAnnotation.GenerateDeprecatedAnnotation(field.Directives); deprecated != "" {
+			body.WriteString("    " + tc.Imports.Resolve(deprecated, []string{deprecatedImport}) + "\n")
+		}
+		g.writeProjectionMethod(&body, className, method, "select(\""+field.Name+"\"")
+	}
+
+	for i, method := range fragments {
+		body.WriteString("    /**\n")
+		body.WriteString("     * Selects fields of " + fragmentTypes[i] + " with an inline fragment.\n")
+		body.WriteString("     */\n")
+		g.writeProjectionMethod(&body, className, method, "fragment(\""+fragmentTypes[i]+"\"")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("package ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n\n")
+
+	if imports := tc.Imports.GenerateImportBlock(); imports != "" {
+		sb.WriteString(imports)
+		sb.WriteString("\n")
+	}
+
+	operation := projectionOperation(ctx.Schema, typeDef.Name)
+
+	sb.WriteString("/**\n")
+	if operation != "" {
+		sb.WriteString(" * Projection building a " + operation + " of " + typeDef.Name + " fields.\n")
+	} else {
+		sb.WriteString(" * Projection selecting fields of " + typeDef.Name + ".\n")
+	}
+	sb.WriteString(" */\n")
+	sb.WriteString("public class " + className + " extends " + ProjectionBaseName + " {\n\n")
+
+	if operation != "" {
+		sb.WriteString("    private " + className + "(String operation) {\n")
+		sb.WriteString("        super(operation);\n")
+		sb.WriteString("    }\n\n")
+	} else {
+		sb.WriteString("    private " + className + "() {\n")
+		sb.WriteString("    }\n\n")
+	}
+	sb.WriteString("    " + className + "(" + ProjectionBaseName + " parent) {\n")
+	sb.WriteString("        super(parent);\n")
+	sb.WriteString("    }\n\n")
+
+	sb.WriteString("    /**\n")
+	if operation != "" {
+		sb.WriteString("     * Creates a projection rendered as a " + operation + ".\n")
+	} else {
+		sb.WriteString("     * Creates a projection rendered as a selection set.\n")
+	}
+	sb.WriteString("     */\n")
+	sb.WriteString("    public static " + className + " root() {\n")
+	if operation != "" {
+		sb.WriteString("        return new " + className + "(\"" + operation + "\");\n")
+	} else {
+		sb.WriteString("        return new " + className + "();\n")
+	}
+	sb.WriteString("    }\n\n")
+
+	sb.WriteString("    /**\n")
+	sb.WriteString("     * Selects the name of the object type.\n")
+	sb.WriteString("     */\n")
+	sb.WriteString("    public " + className + " typename() {\n")
+	sb.WriteString("        select(\"__typename\");\n")
+	sb.WriteString("        return this;\n")
+	sb.WriteString("    }\n")
+
+	if body.Len() > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimSuffix(body.String(), "\n"))
+	}
+	sb.WriteString("}\n")
+
+	return &GeneratedFile{
+		FileName: className + ".java",
+		Content:  sb.String(),
+	}, nil
+}
+
+// projectionArguments sets the parameters of the arguments of a field and the
+// calls passing them. Parameters are boxed, so that null omits an argument.
+func (g *Generator) projectionArguments(tc *TypeContext, field *parser.FieldDef, method *projectionMethod) error {
+	used := make(map[string]bool)
+	for _, arg := range field.Arguments {
+		typeRef := *arg.Type
+		typeRef.NonNull = true
+		result, err := tc.TypeMapper.MapType(&typeRef)
+		if err != nil {
+			return err
+		}
+		javaType := result.JavaType
+		if result.IsPrimitive {
+			javaType = typemap.BoxType(javaType)
+		}
+		javaType = tc.Imports.Resolve(javaType, result.Imports)
+
+		base := EscapeJavaKeyword(tc.NamingHelper.acronyms.camelCase(arg.Name))
+		name := base
+		for n := 2; used[name]; n++ {
+			name = base + strconv.Itoa(n)
+		}
+		used[name] = true
+
+		method.parameters = append(method.parameters, javaType+" "+name)
+		method.arguments = append(method.arguments, "argument(\""+arg.Name+"\", \""+arg.Type.String()+"\", "+name+")")
+	}
+
+	if method.selection != "" {
+		method.addSelection(tc, used)
+	}
+
+	return nil
+}
+
+// addSelection adds the parameter selecting fields of the projection of the
+// method, named unlike the parameters in use, and replaces the projection by
+// the call applying the selection.
+func (m *projectionMethod) addSelection(tc *TypeContext, used map[string]bool) {
+	consumer := "projection"
+	if used[consumer] {
+		consumer = "fields"
+	}
+	consumerType := tc.Imports.Resolve("Consumer<"+m.selection+">", []string{"java.util.function.Consumer"})
+	m.parameters = append(m.parameters, consumerType+" "+consumer)
+	m.selection = "selection(new " + m.selection + "(this), " + consumer + ")"
+}
+
+// writeProjectionMethod writes a method of a projection, which calls the
+// given selecting method of the base class and returns the projection.
+func (g *Generator) writeProjectionMethod(sb *strings.Builder, className string, method *projectionMethod, call string) {
+	sb.WriteString("    public " + className + " " + method.name + "(" + strings.Join(method.parameters, ", ") + ") {\n")
+	sb.WriteString("        " + call)
+	if method.selection != "" {
+		sb.WriteString(", " + method.selection)
+	}
+	for _, argument := range method.arguments {
+		sb.WriteString(", " + argument)
+	}
+	sb.WriteString(");\n")
+	sb.WriteString("        return this;\n")
+	sb.WriteString("    }\n\n")
+}
@@ -0,0 +1,193 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+const projectionSchema = `
+directive @skip on OBJECT | INTERFACE | FIELD_DEFINITION
+type Query {
+  user(id: ID!, includeDeleted: Boolean): User
+  search(text: String!): [SearchResult!]!
+}
+interface Node { id: ID! }
+type User implements Node {
+  id: ID!
+  posts(first: Int!): [Post!]!
+  secret: String @skip
+  audit: Audit
+}
+type Post implements Node { id: ID!, title: String }
+type Audit @skip { id: ID }
+union SearchResult = User | Post
+`
+
+func projectionConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Features.Projections.Enabled = true
+	return cfg
+}
+
+func TestProjections(t *testing.T) {
+	result := generateNamed(t, projectionConfig(), projectionSchema)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	assert.Contains(t, files, "GraphQLProjection.java")
+	assert.Contains(t, files, "NodeProjection.java")
+	assert.Contains(t, files, "PostProjection.java")
+	assert.NotContains(t, files, "AuditProjection.java")
+	assert.NotContains(t, files, "UserInputProjection.java")
+
+	assert.Equal(t, `package com.example.model;
+
+import java.util.function.Consumer;
+
+/**
+ * Projection selecting fields of User.
+ */
+public class UserProjection extends GraphQLProjection {
+
+    private UserProjection() {
+    }
+
+    UserProjection(GraphQLProjection parent) {
+        super(parent);
+    }
+
+    /**
+     * Creates a projection rendered as a selection set.
+     */
+    public static UserProjection root() {
+        return new UserProjection();
+    }
+
+    /**
+     * Selects the name of the object type.
+     */
+    public UserProjection typename() {
+        select("__typename");
+        return this;
+    }
+
+    public UserProjection id() {
+        select("id");
+        return this;
+    }
+
+    public UserProjection posts(Integer first, Consumer<PostProjection> projection) {
+        select("posts", selection(new PostProjection(this), projection), argument("first", "Int!", first));
+        return this;
+    }
+}
+`, files["UserProjection.java"])
+
+	query := files["QueryProjection.java"]
+	assert.Contains(t, query, `    public static QueryProjection root() {
+        return new QueryProjection("query");
+    }
+`)
+	assert.Contains(t, query, `    public QueryProjection user(String id, Boolean includeDeleted, Consumer<UserProjection> projection) {
+        select("user", selection(new UserProjection(this), projection), argument("id", "ID!", id), argument("includeDeleted", "Boolean", includeDeleted));
+        return this;
+    }
+`)
+
+	assert.Contains(t, files["SearchResultProjection.java"], `    /**
+     * Selects fields of User with an inline fragment.
+     */
+    public SearchResultProjection onUser(Consumer<UserProjection> projection) {
+        fragment("User", selection(new UserProjection(this), projection));
+        return this;
+    }
+`)
+	assert.Contains(t, files["NodeProjection.java"], "    public NodeProjection onPost(Consumer<PostProjection> projection) {\n")
+}
+
+func TestProjections_MethodNames(t *testing.T) {
+	result := generateNamed(t, projectionConfig(), `
+type Query {
+  root: String
+  typename: String
+  typenameField: String
+  item(projection: String, class: Int): Item
+}
+type Item { id: ID }
+`)
+	require.Empty(t, result.Errors)
+	query := fileContents(result)["QueryProjection.java"]
+
+	assert.Contains(t, query, "    public QueryProjection rootField() {\n")
+	assert.Contains(t, query, "    public QueryProjection typenameField() {\n")
+	assert.Contains(t, query, "    public QueryProjection typenameFieldField() {\n")
+	assert.Contains(t, query, "    public QueryProjection item(String projection, Integer _class, Consumer<ItemProjection> fields) {\n")
+}
+
+func TestProjections_Collision(t *testing.T) {
+	result := generateNamed(t, projectionConfig(), `
+type Query { user: User, projection: UserProjection }
+type User { id: ID }
+type UserProjection { id: ID }
+`)
+
+	assert.Equal(t, []string{"projection UserProjection collides with a generated type"}, errorMessages(result.Errors))
+	files := fileContents(result)
+	assert.Contains(t, files, "UserProjectionProjection.java")
+	assert.NotContains(t, files["QueryProjection.java"], " user(")
+}
+
+func TestProjections_Disabled(t *testing.T) {
+	result := generateNamed(t, config.DefaultConfig(), projectionSchema)
+	require.Empty(t, result.Errors)
+
+	assert.NotContains(t, fileContents(result), "GraphQLProjection.java")
+}
+
+func TestProjections_Incremental(t *testing.T) {
+	schema, err := parser.NewParser().Parse(projectionSchema, "test.graphql")
+	require.NoError(t, err)
+
+	result := NewGenerator(projectionConfig()).GenerateTypes(schema, []string{"Post"})
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	// Projections select fields across types, so any change regenerates them
+	assert.Contains(t, files, "GraphQLProjection.java")
+	assert.Contains(t, files, "QueryProjection.java")
+	assert.Contains(t, files, "UserProjection.java")
+	assert.NotContains(t, files, "User.java")
+
+	stats := GetStats(result.Files, nil)
+	assert.Equal(t, 1, stats.TotalTypes)
+	assert.Equal(t, 1, stats.Classes)
+}
+
+func TestProjections_ElementAnnotations(t *testing.T) {
+	cfg := projectionConfig()
+	cfg.Features.Controllers.Enabled = true
+	cfg.Features.Validation.Enabled = true
+	cfg.Features.Validation.NotNullOnNonNull = true
+	cfg.Java.NullableHandling = config.NullableAnnotation
+	cfg.Java.Nullability.NonNull = true
+
+	result := generateNamed(t, cfg, `
+type Query { users(ids: [ID!]!): [User!]! }
+type User { id: ID!, tags: [String!] }
+`)
+	require.Empty(t, result.Errors)
+	files := fileContents(result)
+
+	// Element annotations belong to the bean properties only
+	assert.Contains(t, files["User.java"], "List<@NotNull @NonNull String> tags;")
+	for _, name := range []string{"QueryProjection.java", "QueryController.java"} {
+		assert.NotContains(t, files[name], "<@", name)
+		assert.NotContains(t, files[name], "@Valid", name)
+	}
+	assert.Contains(t, files["QueryProjection.java"], "List<String> ids")
+}
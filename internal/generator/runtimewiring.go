@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// runtimeWiringImports are the graphql-java types the runtime wiring uses.
+var runtimeWiringImports = []string{
+	"graphql.schema.GraphQLScalarType",
+	"graphql.schema.idl.RuntimeWiring",
+	"graphql.schema.idl.TypeRuntimeWiring",
+}
+
+// generateRuntimeWiringFile generates the class adding the custom scalars,
+// the type resolvers of interfaces and unions and the values of enums to a
+// graphql-java RuntimeWiring, if enabled in the configuration. Scalars of
+// the schema without a Coercing are reported as warnings, since building
+// the schema fails without them.
+func (g *Generator) generateRuntimeWiringFile(ctx *Context) *GeneratedFile {
+	wiring := ctx.Config.Features.RuntimeWiring
+	if !wiring.Enabled {
+		return nil
+	}
+
+	className := wiring.ClassName
+	if className == "" {
+		className = config.DefaultConfig().Features.RuntimeWiring.ClassName
+	}
+
+	imports := ctx.importManager()
+	imports.Reserve(className)
+	builder := imports.Resolve("RuntimeWiring", runtimeWiringImports) + ".Builder"
+	typeWiring := imports.Resolve("TypeRuntimeWiring", runtimeWiringImports)
+
+	var body strings.Builder
+	for _, scalar := range g.wiredScalars(ctx) {
+		coercing := ctx.Config.TypeMappings.Scalars[scalar].Coercing
+		var coercingImports []string
+		if i := strings.LastIndex(coercing, "."); i >= 0 {
+			coercingImports = []string{coercing}
+			coercing = coercing[i+1:]
+		}
+
+		body.WriteString("        builder.scalar(" + imports.Resolve("GraphQLScalarType", runtimeWiringImports) + ".newScalar()\n")
+		body.WriteString("                .name(" + strconv.Quote(scalar) + ")\n")
+		body.WriteString("                .coercing(new " + imports.Resolve(coercing, coercingImports) + "())\n")
+		body.WriteString("                .build());\n")
+	}
+
+	for _, typeDef := range ctx.namedTypes() {
+		switch typeDef.Kind {
+		case parser.TypeKindInterface, parser.TypeKindUnion:
+			writeTypeResolver(&body, ctx, typeDef, typeWiring, imports)
+		case parser.TypeKindEnum:
+			writeEnumValues(&body, ctx, typeDef, typeWiring)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("package ")
+	sb.WriteString(ctx.Config.Output.Package)
+	sb.WriteString(";\n\n")
+
+	if block := imports.GenerateImportBlock(); block != "" {
+		sb.WriteString(block)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("/**\n")
+	sb.WriteString(" * Wires the custom scalars, the type resolvers of interfaces and unions and\n")
+	sb.WriteString(" * the enum values of the schema into a graphql-java RuntimeWiring.\n")
+	sb.WriteString(" */\n")
+	sb.WriteString("public final class " + className + " {\n\n")
+	sb.WriteString("    private " + className + "() {\n")
+	sb.WriteString("    }\n\n")
+	sb.WriteString("    /**\n")
+	sb.WriteString("     * Creates a RuntimeWiring builder with the generated wiring, to which\n")
+	sb.WriteString("     * data fetchers can be added.\n")
+	sb.WriteString("     */\n")
+	sb.WriteString("    public static " + builder + " newRuntimeWiring() {\n")
+	sb.WriteString("        return configure(" + strings.TrimSuffix(builder, ".Builder") + ".newRuntimeWiring());\n")
+	sb.WriteString("    }\n\n")
+	sb.WriteString("    /**\n")
+	sb.WriteString("     * Adds the generated wiring to a RuntimeWiring builder.\n")
+	sb.WriteString("     */\n")
+	sb.WriteString("    public static " + builder + " configure(" + builder + " builder) {\n")
+	sb.WriteString(body.String())
+	sb.WriteString("        return builder;\n")
+	sb.WriteString("    }\n")
+	sb.WriteString("}\n")
+
+	return &GeneratedFile{
+		FileName: className + ".java",
+		Content:  sb.String(),
+	}
+}
+
+// wiredScalars returns the scalars of the type mappings with a Coercing, in
+// order, and warns about the custom scalars of the schema without one.
+func (g *Generator) wiredScalars(ctx *Context) []string {
+	var scalars []string
+	for name, mapping := range ctx.Config.TypeMappings.Scalars {
+		if mapping.Coercing != "" {
+			scalars = append(scalars, name)
+		}
+	}
+	sort.Strings(scalars)
+
+	for _, typeDef := range ctx.Schema.TypesByKind(parser.TypeKindScalar) {
+		if parser.IsFederationType(typeDef.Name) || ctx.Config.TypeMappings.Scalars[typeDef.Name].Coercing != "" {
+			continue
+		}
+		err := errors.NewGenerateError(
+			fmt.Sprintf("scalar %s has no coercing and is not wired by the runtime wiring", typeDef.Name),
+			nil,
+		).WithTypeName(typeDef.Name)
+		err.WithLocation(typeDef.Location)
+		err.Hint = "set typeMappings.scalars." + typeDef.Name + ".coercing to its Coercing implementation"
+		ctx.Warn(err)
+	}
+
+	return scalars
+}
+
+// writeTypeResolver writes the type wiring of an interface or union whose
+// type resolver returns the object type of the generated class the resolved
+// object is an instance of. A generated type named Object shadows
+// java.lang.Object, which is then qualified.
+func writeTypeResolver(sb *strings.Builder, ctx *Context, typeDef *parser.TypeDef, typeWiring string, imports *ImportManager) {
+	var possibleTypes []*parser.TypeDef
+	for _, t := range ctx.namedTypes() {
+		if t.Kind != parser.TypeKindObject || len(ctx.nameErrors[t.Name]) > 0 {
+			continue
+		}
+		// Objects list all interfaces they implement, including those
+		// implemented through other interfaces
+		if typeDef.Kind == parser.TypeKindUnion && containsString(typeDef.PossibleTypes, t.Name) ||
+			typeDef.Kind == parser.TypeKindInterface && containsString(t.Interfaces, typeDef.Name) {
+			possibleTypes = append(possibleTypes, t)
+		}
+	}
+	sort.Slice(possibleTypes, func(i, j int) bool {
+		return possibleTypes[i].Name < possibleTypes[j].Name
+	})
+
+	sb.WriteString("        builder.type(" + typeWiring + ".newTypeWiring(" + strconv.Quote(typeDef.Name) + ")\n")
+	sb.WriteString("                .typeResolver(env -> {\n")
+	sb.WriteString("                    " + imports.Resolve("Object", []string{"java.lang.Object"}) + " object = env.getObject();\n")
+	for _, t := range possibleTypes {
+		sb.WriteString("                    if (object instanceof " + ctx.NamingHelper.GetTypeName(t) + ") {\n")
+		sb.WriteString("                        return env.getSchema().getObjectType(" + strconv.Quote(t.Name) + ");\n")
+		sb.WriteString("                    }\n")
+	}
+	sb.WriteString("                    return null;\n")
+	sb.WriteString("                }));\n")
+}
+
+// writeEnumValues writes the type wiring of an enum mapping its values to
+// the constants of the generated enum. Values without a constant keep their
+// name as value.
+func writeEnumValues(sb *strings.Builder, ctx *Context, typeDef *parser.TypeDef, typeWiring string) {
+	if len(ctx.nameErrors[typeDef.Name]) > 0 {
+		return
+	}
+	tc := NewTypeContext(ctx, typeDef)
+
+	sb.WriteString("        builder.type(" + typeWiring + ".newTypeWiring(" + strconv.Quote(typeDef.Name) + ")\n")
+	sb.WriteString("                .enumValues(name -> {\n")
+	sb.WriteString("                    switch (name) {\n")
+	for _, enumValue := range typeDef.EnumValues {
+		if tc.ShouldSkipEnumValue(enumValue) {
+			continue
+		}
+		sb.WriteString("                        case " + strconv.Quote(enumValue.Name) + ":\n")
+		sb.WriteString("                            return " + tc.TypeName + "." + tc.NamingHelper.GetEnumValueName(enumValue) + ";\n")
+	}
+	sb.WriteString("                        default:\n")
+	sb.WriteString("                            return null;\n")
+	sb.WriteString("                    }\n")
+	sb.WriteString("                }));\n")
+}
+
+// containsString returns true if values contains value.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+)
+
+const runtimeWiringSchema = `
+directive @skip on OBJECT | INTERFACE | FIELD_DEFINITION | ENUM_VALUE
+directive @javaName(name: String!) on OBJECT | ENUM_VALUE
+scalar DateTime
+type Query { node(id: ID!): Node }
+interface Node { id: ID! }
+type User implements Node { id: ID!, at: DateTime }
+type Post implements Node @javaName(name: "Article") { id: ID! }
+type Draft implements Node @skip { id: ID! }
+union SearchResult = User | Post
+enum Status { ACTIVE, in_progress @javaName(name: "PENDING"), hidden @skip }
+`
+
+func runtimeWiringConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Features.RuntimeWiring.Enabled = true
+	cfg.TypeMappings.Scalars = map[string]config.ScalarMapping{
+		"DateTime": {JavaType: "OffsetDateTime", Imports: []string{"java.time.OffsetDateTime"}, Coercing: "com.example.scalars.DateTimeCoercing"},
+	}
+	return cfg
+}
+
+func TestRuntimeWiring(t *testing.T) {
+	result := generateNamed(t, runtimeWiringConfig(), runtimeWiringSchema)
+	require.Empty(t, result.Errors)
+	assert.Empty(t, result.Warnings)
+
+	assert.Equal(t, `package com.example.model;
+
+import com.example.scalars.DateTimeCoercing;
+
+import graphql.schema.GraphQLScalarType;
+import graphql.schema.idl.RuntimeWiring;
+import graphql.schema.idl.TypeRuntimeWiring;
+
+/**
+ * Wires the custom scalars, the type resolvers of interfaces and unions and
+ * the enum values of the schema into a graphql-java RuntimeWiring.
+ */
+public final class GeneratedRuntimeWiring {
+
+    private GeneratedRuntimeWiring() {
+    }
+
+    /**
+     * Creates a RuntimeWiring builder with the generated wiring, to which
+     * data fetchers can be added.
+     */
+    public static RuntimeWiring.Builder newRuntimeWiring() {
+        return configure(RuntimeWiring.newRuntimeWiring());
+    }
+
+    /**
+     * Adds the generated wiring to a RuntimeWiring builder.
+     */
+    public static RuntimeWiring.Builder configure(RuntimeWiring.Builder builder) {
+        builder.scalar(GraphQLScalarType.newScalar()
+                .name("DateTime")
+                .coercing(new DateTimeCoercing())
+                .build());
+        builder.type(TypeRuntimeWiring.newTypeWiring("Node")
+                .typeResolver(env -> {
+                    Object object = env.getObject();
+                    if (object instanceof Article) {
+                        return env.getSchema().getObjectType("Post");
+                    }
+                    if (object instanceof User) {
+                        return env.getSchema().getObjectType("User");
+                    }
+                    return null;
+                }));
+        builder.type(TypeRuntimeWiring.newTypeWiring("SearchResult")
+                .typeResolver(env -> {
+                    Object object = env.getObject();
+                    if (object instanceof Article) {
+                        return env.getSchema().getObjectType("Post");
+                    }
+                    if (object instanceof User) {
+                        return env.getSchema().getObjectType("User");
+                    }
+                    return null;
+                }));
+        builder.type(TypeRuntimeWiring.newTypeWiring("Status")
+                .enumValues(name -> {
+                    switch (name) {
+                        case "ACTIVE":
+                            return Status.ACTIVE;
+                        case "in_progress":
+                            return Status.PENDING;
+                        default:
+                            return null;
+                    }
+                }));
+        return builder;
+    }
+}
+`, fileContents(result)["GeneratedRuntimeWiring.java"])
+}
+
+func TestRuntimeWiring_ScalarWithoutCoercing(t *testing.T) {
+	cfg := runtimeWiringConfig()
+	cfg.Features.RuntimeWiring.ClassName = "Wiring"
+	result := generateNamed(t, cfg, `
+scalar DateTime
+scalar JSON
+type Query { at: DateTime, data: JSON }
+`)
+	require.Empty(t, result.Errors)
+
+	assert.Equal(t, []string{"scalar JSON has no coercing and is not wired by the runtime wiring"}, warningMessages(result.Warnings))
+	assert.Contains(t, fileContents(result)["Wiring.java"], ".name(\"DateTime\")")
+}
+
+func TestRuntimeWiring_Disabled(t *testing.T) {
+	result := generateNamed(t, config.DefaultConfig(), runtimeWiringSchema)
+	require.Empty(t, result.Errors)
+
+	assert.NotContains(t, fileContents(result), "GeneratedRuntimeWiring.java")
+}
+
+func TestRuntimeWiring_ObjectTypeShadowsJavaLang(t *testing.T) {
+	result := generateNamed(t, runtimeWiringConfig(), `
+type Query { result: Result }
+type Object { id: ID }
+type Override { id: ID }
+union Result = Object | Override
+`)
+	require.Empty(t, result.Errors)
+	wiring := fileContents(result)["GeneratedRuntimeWiring.java"]
+
+	assert.Contains(t, wiring, `                    java.lang.Object object = env.getObject();
+                    if (object instanceof Object) {
+                        return env.getSchema().getObjectType("Object");
+                    }
+                    if (object instanceof Override) {
+`)
+	assert.NotContains(t, wiring, "import java.lang.Object;")
+	assert.Equal(t, 4, GetStats(result.Files, nil).TotalTypes)
+}
@@ -0,0 +1,241 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// Warn records a problem that does not stop generation but likely produces
+// code that differs from what the schema author intended. Identical warnings
+// are recorded once.
+func (c *Context) Warn(err error) {
+	if err == nil {
+		return
+	}
+	key := err.Error()
+	if c.warningKeys[key] {
+		return
+	}
+	c.warningKeys[key] = true
+	c.warnings = append(c.warnings, err)
+}
+
+// Warnings returns the recorded warnings ordered by location.
+func (c *Context) Warnings() []error {
+	warnings := make([]error, len(c.warnings))
+	copy(warnings, c.warnings)
+
+	sort.SliceStable(warnings, func(i, j int) bool {
+		a, b := errors.Diagnostics(warnings[i])[0], errors.Diagnostics(warnings[j])[0]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Message < b.Message
+	})
+	return warnings
+}
+
+// warnMapping records a type mapping warning for a field of the type being
+// generated.
+func (tc *TypeContext) warnMapping(field *parser.FieldDef, err error) {
+	if mappingErr, ok := err.(*errors.TypeMappingError); ok {
+		mappingErr.WithContext("type", tc.TypeDef.Name).WithContext("field", field.Name)
+		mappingErr.WithLocation(field.Location)
+	}
+	tc.Warn(err)
+}
+
+// checkType records warnings for a type that is about to be generated:
+// misused gql2j directives, references to skipped types and fields @oneOf
+// does not allow.
+func (tc *TypeContext) checkType() {
+	if tc.ShouldSkip() {
+		return
+	}
+
+	tc.checkDirectives(tc.TypeDef.Directives, parser.TypeLocation(tc.TypeDef.Kind), "")
+	for _, ev := range tc.TypeDef.EnumValues {
+		if !tc.ShouldSkipEnumValue(ev) {
+			tc.checkDirectives(ev.Directives, ast.LocationEnumValue, "")
+		}
+	}
+
+	for _, iface := range tc.TypeDef.Interfaces {
+		if tc.isSkippedType(iface) {
+			err := errors.NewGenerateError(
+				fmt.Sprintf("type %s implements skipped interface %s, which is not generated", tc.TypeDef.Name, iface),
+				nil,
+			).WithTypeName(tc.TypeDef.Name)
+			err.WithLocation(tc.TypeDef.Location)
+			tc.Warn(err)
+		}
+	}
+
+	for _, field := range tc.TypeDef.Fields {
+		fc, err := NewFieldContext(tc, field)
+		if err != nil || fc.ShouldSkip() {
+			// Errors are reported when the field is generated
+			continue
+		}
+		tc.checkDirectives(field.Directives, parser.FieldLocation(tc.TypeDef.Kind), field.Name)
+		tc.checkConstraints(fc)
+		tc.checkOneOfField(field)
+
+		if field.Type == nil || parser.ExtractJavaTypeDirective(field.Directives) != nil {
+			continue
+		}
+		if target := field.Type.NamedType(); tc.isSkippedType(target) {
+			err := errors.NewGenerateError(
+				fmt.Sprintf("field %s.%s references skipped type %s, which is not generated", tc.TypeDef.Name, field.Name, target),
+				nil,
+			).WithTypeName(tc.TypeDef.Name).WithFieldName(field.Name)
+			err.WithLocation(field.Location)
+			tc.Warn(err)
+		}
+	}
+
+	tc.checkEntity()
+}
+
+// checkEntity records warnings for a JPA entity without an @id field, for
+// fields referencing object types that are not entities, which JPA cannot
+// map, and for @relation on fields that are not relationships.
+func (tc *TypeContext) checkEntity() {
+	if !tc.PersistenceGen.IsEntity(tc.TypeDef) {
+		return
+	}
+
+	hasID := false
+	for _, field := range tc.TypeDef.Fields {
+		fc, err := NewFieldContext(tc, field)
+		if err != nil || fc.ShouldSkip() || field.Type == nil {
+			continue
+		}
+		if field.HasDirective(parser.DirectiveID) {
+			hasID = true
+		}
+		if field.HasDirective(parser.DirectiveTransient) || parser.ExtractJavaTypeDirective(field.Directives) != nil {
+			continue
+		}
+
+		warn := func(message, hint string) {
+			err := errors.NewGenerateError(message, nil).WithTypeName(tc.TypeDef.Name).WithFieldName(field.Name)
+			err.WithLocation(field.Location)
+			err.Hint = hint
+			tc.Warn(err)
+		}
+
+		target := field.Type.NamedType()
+		relation := parser.ExtractRelationDirective(field.Directives)
+		if tc.isEntityType(target) {
+			if relation != nil && relation.Kind == parser.RelationManyToOne && relation.MappedBy != "" {
+				warn(fmt.Sprintf("field %s.%s: mappedBy does not apply to a many-to-one relationship", tc.TypeDef.Name, field.Name),
+					"set mappedBy on the one-to-many side of the relationship")
+			}
+			continue
+		}
+		if relation != nil {
+			warn(fmt.Sprintf("field %s.%s has @relation but does not reference an entity", tc.TypeDef.Name, field.Name), "")
+		}
+		if typeDef := tc.Schema.GetType(target); typeDef != nil && !tc.isSkippedType(target) {
+			switch typeDef.Kind {
+			case parser.TypeKindObject, parser.TypeKindInterface, parser.TypeKindUnion:
+				warn(fmt.Sprintf("field %s.%s of entity %s references %s, which is not an entity", tc.TypeDef.Name, field.Name, tc.TypeDef.Name, target),
+					"add @entity to "+target+" or @transient to the field")
+			}
+		}
+	}
+
+	if !hasID {
+		err := errors.NewGenerateError(fmt.Sprintf("entity %s has no @id field", tc.TypeDef.Name), nil).
+			WithTypeName(tc.TypeDef.Name)
+		err.WithLocation(tc.TypeDef.Location)
+		err.Hint = "add @id to the field holding its identifier"
+		tc.Warn(err)
+	}
+}
+
+// checkDirectives records a warning for every misuse of a gql2j directive,
+// since generation ignores what does not match the directive signature, and
+// for every annotation of a mapped directive that cannot be generated.
+func (tc *TypeContext) checkDirectives(directives []*parser.DirectiveDef, location ast.DirectiveLocation, fieldName string) {
+	errs := parser.ValidateDirectives(directives, location)
+	errs = append(errs, tc.CustomAnnotation.CheckDirectiveAnnotations(directives)...)
+	for _, err := range errs {
+		err.WithTypeName(tc.TypeDef.Name)
+		if fieldName != "" {
+			err.WithFieldName(fieldName)
+		}
+		tc.Warn(err)
+	}
+}
+
+// checkOneOfField records a warning for a field of a @oneOf input object
+// that is non-null or has a default value, which @oneOf does not allow:
+// exactly one field is set by the client.
+func (tc *TypeContext) checkOneOfField(field *parser.FieldDef) {
+	if !tc.TypeDef.IsOneOf() {
+		return
+	}
+
+	var message string
+	switch {
+	case field.Type != nil && field.Type.NonNull:
+		message = fmt.Sprintf("field %s.%s of @oneOf input must be nullable", tc.TypeDef.Name, field.Name)
+	case field.DefaultValue != nil:
+		message = fmt.Sprintf("field %s.%s of @oneOf input cannot have a default value", tc.TypeDef.Name, field.Name)
+	default:
+		return
+	}
+	err := errors.NewGenerateError(message, nil).WithTypeName(tc.TypeDef.Name).WithFieldName(field.Name)
+	err.WithLocation(field.Location)
+	tc.Warn(err)
+}
+
+// checkConstraints records a warning for every @constraint argument of a
+// field that does not apply to its Java type or is inconsistent.
+func (tc *TypeContext) checkConstraints(fc *FieldContext) {
+	constraint := parser.ExtractConstraintDirective(fc.Field.Directives)
+	if constraint == nil || fc.Field.Type == nil {
+		return
+	}
+
+	// Java types of scalars and @javaType may support any constraint
+	typeDef := tc.Schema.GetType(fc.Field.Type.NamedType())
+	isScalar := typeDef == nil || typeDef.Kind == parser.TypeKindScalar ||
+		parser.ExtractJavaTypeDirective(fc.Field.Directives) != nil
+
+	var directive *parser.DirectiveDef
+	for _, d := range fc.Field.Directives {
+		if d.Name == parser.DirectiveConstraint {
+			directive = d
+			break
+		}
+	}
+	for _, err := range tc.ValidationGen.CheckConstraints(constraint, fc.JavaType, isScalar) {
+		err.WithTypeName(tc.TypeDef.Name).WithFieldName(fc.Field.Name)
+		err.Location = directive.Location
+		tc.Warn(err)
+	}
+}
+
+// isSkippedType returns true if the named schema type is not generated
+// because of @skip or @inaccessible.
+func (tc *TypeContext) isSkippedType(name string) bool {
+	typeDef := tc.Schema.GetType(name)
+	if typeDef == nil || parser.IsFederationType(name) {
+		return false
+	}
+	return NewTypeContext(tc.Context, typeDef).ShouldSkip()
+}
@@ -0,0 +1,237 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+func generateWarnings(t *testing.T, cfg *config.Config, sdl string) []error {
+	schema, err := parser.NewParser().Parse(sdl, "test.graphql")
+	require.NoError(t, err)
+
+	result := NewGenerator(cfg).GenerateWithResult(schema)
+	require.Empty(t, result.Errors)
+	return result.Warnings
+}
+
+func warningMessages(warnings []error) []string {
+	var messages []string
+	for _, w := range warnings {
+		messages = append(messages, errors.Diagnostics(w)[0].Message)
+	}
+	return messages
+}
+
+func TestGenerateWithResult_NoWarnings(t *testing.T) {
+	warnings := generateWarnings(t, config.DefaultConfig(), `
+scalar DateTime
+type Query { user: User }
+type User { id: ID!, createdAt: DateTime, score: Float }
+`)
+	assert.Empty(t, warnings)
+}
+
+func TestGenerateWithResult_UnmappedScalar(t *testing.T) {
+	warnings := generateWarnings(t, config.DefaultConfig(), `
+scalar Money
+type Order {
+  total: Money
+  refunds: [Money]
+}
+`)
+
+	require.Len(t, warnings, 2)
+	d := errors.Diagnostics(warnings[0])[0]
+	assert.Equal(t, errors.CodeTypemap, d.Code)
+	assert.Equal(t, "scalar Money has no Java mapping, will use as-is", d.Message)
+	assert.Equal(t, "Order", d.Type)
+	assert.Equal(t, "total", d.Field)
+	assert.Equal(t, "test.graphql", d.File)
+	assert.Equal(t, 4, d.Line)
+}
+
+func TestGenerateWithResult_LossyMapping(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TypeMappings.Scalars["Float"] = config.ScalarMapping{JavaType: "Float"}
+	cfg.TypeMappings.Scalars["Decimal"] = config.ScalarMapping{JavaType: "double"}
+
+	warnings := generateWarnings(t, cfg, `
+scalar Decimal
+type Product {
+  weight: Float
+  price: Decimal
+  name: String
+}
+`)
+
+	assert.Equal(t, []string{
+		"Float mapped to Float loses precision",
+		"Decimal mapped to double loses precision",
+	}, warningMessages(warnings))
+}
+
+func TestGenerateWithResult_IgnoredDirectiveArguments(t *testing.T) {
+	warnings := generateWarnings(t, config.DefaultConfig(), `
+directive @javaName(name: String, nam: String) on OBJECT | FIELD_DEFINITION
+directive @constraint(minLength: Int, maxLength: String) on FIELD_DEFINITION
+type User @javaName(nam: "Account") {
+  name: String @constraint(minLength: 1, maxLength: "10")
+}
+`)
+
+	assert.Equal(t, []string{
+		`@javaName has no argument "nam"`,
+		`@javaName requires argument "name"`,
+		`argument "maxLength" of @constraint must be Int`,
+	}, warningMessages(warnings))
+
+	d := errors.Diagnostics(warnings[2])[0]
+	assert.Equal(t, errors.CodeDirective, d.Code)
+	assert.Equal(t, "constraint", d.Directive)
+	assert.Equal(t, "User", d.Type)
+	assert.Equal(t, "name", d.Field)
+}
+
+func TestGenerateWithResult_InapplicableConstraints(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Features.Validation.Enabled = true
+	warnings := generateWarnings(t, cfg, `
+directive @constraint(past: Boolean, minLength: Int, positive: Boolean) on INPUT_FIELD_DEFINITION
+input Address { street: String }
+input Person {
+  name: String @constraint(past: true)
+  tags: [String] @constraint(minLength: 1)
+  address: Address @constraint(positive: true)
+}
+`)
+
+	assert.Equal(t, []string{
+		`@constraint argument "past" does not apply to String`,
+		`@constraint argument "minLength" does not apply to List<String>`,
+		`@constraint argument "positive" does not apply to Address`,
+	}, warningMessages(warnings))
+
+	d := errors.Diagnostics(warnings[0])[0]
+	assert.Equal(t, "constraint", d.Directive)
+	assert.Equal(t, "Person", d.Type)
+	assert.Equal(t, "name", d.Field)
+	assert.Equal(t, "it applies to date and time types", d.Hint)
+	assert.Equal(t, 5, d.Line)
+}
+
+func TestGenerateWithResult_SkippedTypeReferenced(t *testing.T) {
+	warnings := generateWarnings(t, config.DefaultConfig(), `
+directive @skip on OBJECT | INTERFACE | FIELD_DEFINITION
+interface Internal @skip { id: ID }
+type Audit @skip { id: ID }
+type User implements Internal {
+  id: ID
+  audit: Audit
+  hidden: Audit @skip
+}
+`)
+
+	assert.Equal(t, []string{
+		"type User implements skipped interface Internal, which is not generated",
+		"field User.audit references skipped type Audit, which is not generated",
+	}, warningMessages(warnings))
+}
+
+func TestContext_Warn_Deduplicates(t *testing.T) {
+	ctx := NewContext(config.DefaultConfig(), &parser.Schema{Types: map[string]*parser.TypeDef{}})
+
+	ctx.Warn(errors.NewGenerateError("same", nil))
+	ctx.Warn(errors.NewGenerateError("same", nil))
+	ctx.Warn(nil)
+
+	assert.Len(t, ctx.Warnings(), 1)
+}
+
+func TestGenerateWithResult_DirectiveHints(t *testing.T) {
+	warnings := generateWarnings(t, config.DefaultConfig(), `
+directive @javaName(name: String, nmae: String) on OBJECT
+directive @constraints(min: Int) on FIELD_DEFINITION
+directive @cache(ttl: Int) on FIELD_DEFINITION
+type User @javaName(nmae: "Account") {
+  age: Int @constraints(min: 1)
+  name: String @cache(ttl: 60)
+}
+`)
+
+	require.Len(t, warnings, 3)
+
+	d := errors.Diagnostics(warnings[0])[0]
+	assert.Equal(t, `@javaName has no argument "nmae"`, d.Message)
+	assert.Equal(t, "did you mean name?", d.Hint)
+
+	d = errors.Diagnostics(warnings[2])[0]
+	assert.Equal(t, "@constraints is not a gql2j directive", d.Message)
+	assert.Equal(t, "did you mean @constraint?", d.Hint)
+	assert.Equal(t, "age", d.Field)
+}
+
+func TestGenerateWithResult_MisusedDirectives(t *testing.T) {
+	warnings := generateWarnings(t, config.DefaultConfig(), `
+directive @lombok(exclude: [String]) on OBJECT | FIELD_DEFINITION
+directive @collection(type: String) on FIELD_DEFINITION
+type User {
+  name: String @lombok(exclude: ["builder"])
+  tags: [String] @collection(type: "Vector")
+}
+`)
+
+	assert.Equal(t, []string{
+		"@lombok cannot be used on FIELD_DEFINITION",
+		`"Vector" is not a valid value for argument "type" of @collection`,
+	}, warningMessages(warnings))
+
+	d := errors.Diagnostics(warnings[0])[0]
+	assert.Equal(t, "allowed on OBJECT, INPUT_OBJECT", d.Hint)
+	assert.Equal(t, 5, d.Line)
+}
+
+func TestGenerateWithResult_OneOfFields(t *testing.T) {
+	warnings := generateWarnings(t, config.DefaultConfig(), `
+input PaymentInput @oneOf {
+  card: String!
+  iban: String = "DE00"
+  points: Int
+}
+`)
+
+	assert.Equal(t, []string{
+		"field PaymentInput.card of @oneOf input must be nullable",
+		"field PaymentInput.iban of @oneOf input cannot have a default value",
+	}, warningMessages(warnings))
+}
+
+func TestGenerateWithResult_DirectiveAnnotations(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.DirectiveAnnotations = map[string][]config.AnnotationConfig{
+		"phone": {{Value: `@com.acme.Phone(region = "{region}")`}},
+	}
+
+	result := generateNamed(t, cfg, `
+directive @phone(region: String) on FIELD_DEFINITION
+type User {
+  mobile: String @phone(region: "DE")
+  home: String @phone
+}
+`)
+	require.Empty(t, result.Errors)
+
+	content := fileContents(result)["User.java"]
+	assert.Contains(t, content, "import com.acme.Phone;\n")
+	assert.Contains(t, content, "    @Phone(region = \"DE\")\n    private String mobile;\n")
+	assert.Contains(t, content, "\n    private String home;\n")
+
+	assert.Equal(t, []string{
+		`annotation @com.acme.Phone(region = "{region}") of @phone is not generated: argument "region" has no value`,
+	}, warningMessages(result.Warnings))
+}
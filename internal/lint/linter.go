@@ -0,0 +1,135 @@
+// Package lint checks GraphQL schemas for patterns that are hostile to Java
+// code generation.
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// rule is a single lint check. It reports findings through the linter.
+type rule struct {
+	name  string
+	check func(l *Linter, schema *parser.Schema, types []*parser.TypeDef)
+}
+
+// rules lists all lint rules in the order they run.
+var rules = []rule{
+	{config.LintNamingConvention, checkNamingConvention},
+	{config.LintMissingDescription, checkMissingDescription},
+	{config.LintUnusedType, checkUnusedTypes},
+	{config.LintNullableListElement, checkNullableListElements},
+	{config.LintInconsistentID, checkInconsistentIDs},
+	{config.LintDirectiveArgumentType, checkDirectiveArgumentTypes},
+	{config.LintJavaNameIdentifier, checkJavaNameIdentifiers},
+}
+
+// Linter runs the configured lint rules over a schema.
+type Linter struct {
+	severities map[string]string
+	current    string
+	findings   []*errors.LintError
+}
+
+// NewLinter creates a linter using the rule severities from the configuration.
+// Rules missing from the configuration use their default severity.
+func NewLinter(cfg *config.LintConfig) *Linter {
+	severities := config.DefaultLintRules()
+	if cfg != nil {
+		for name, severity := range cfg.Rules {
+			severities[name] = severity
+		}
+	}
+	return &Linter{severities: severities}
+}
+
+// Lint runs all enabled rules and returns the findings sorted by location.
+func (l *Linter) Lint(schema *parser.Schema) []*errors.LintError {
+	l.findings = nil
+
+	types := lintedTypes(schema)
+	for _, r := range rules {
+		if l.severities[r.name] == config.SeverityOff || l.severities[r.name] == "" {
+			continue
+		}
+		l.current = r.name
+		r.check(l, schema, types)
+	}
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		return lessLocation(l.findings[i].Location, l.findings[j].Location)
+	})
+
+	return l.findings
+}
+
+// report records a finding of the rule that is currently running.
+func (l *Linter) report(loc *errors.Location, typeName, fieldName, format string, args ...interface{}) *errors.LintError {
+	finding := errors.NewLintError(l.current, l.severities[l.current], fmt.Sprintf(format, args...))
+	finding.WithLocation(loc)
+	if typeName != "" {
+		finding.WithTypeName(typeName)
+	}
+	if fieldName != "" {
+		finding.WithFieldName(fieldName)
+	}
+	l.findings = append(l.findings, finding)
+	return finding
+}
+
+// HasErrors returns true if any finding has error severity.
+func HasErrors(findings []*errors.LintError) bool {
+	for _, f := range findings {
+		if f.Severity == config.SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// lintedTypes returns the user-defined types sorted by name. Federation
+// infrastructure types are not linted.
+func lintedTypes(schema *parser.Schema) []*parser.TypeDef {
+	var types []*parser.TypeDef
+	for name, typeDef := range schema.Types {
+		if parser.IsFederationType(name) {
+			continue
+		}
+		types = append(types, typeDef)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types
+}
+
+// lintedFields returns the fields of a type, leaving out introspection
+// fields and the federation query fields.
+func lintedFields(schema *parser.Schema, typeDef *parser.TypeDef) []*parser.FieldDef {
+	var fields []*parser.FieldDef
+	for _, field := range typeDef.Fields {
+		if len(field.Name) >= 2 && field.Name[:2] == "__" {
+			continue
+		}
+		if parser.IsFederationQueryField(field.Name) && schema.IsRootType(typeDef.Name) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func lessLocation(a, b *errors.Location) bool {
+	if a == nil || b == nil {
+		return a != nil && b == nil
+	}
+	if a.File != b.File {
+		return a.File < b.File
+	}
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Column < b.Column
+}
@@ -0,0 +1,247 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+func lintSchema(t *testing.T, input string, rules map[string]string) []*errors.LintError {
+	schema, err := parser.NewParser().Parse(input, "schema.graphql")
+	require.NoError(t, err)
+
+	// Only run the rules under test
+	cfg := &config.LintConfig{Rules: map[string]string{}}
+	for name := range config.DefaultLintRules() {
+		cfg.Rules[name] = config.SeverityOff
+	}
+	for name, severity := range rules {
+		cfg.Rules[name] = severity
+	}
+
+	return NewLinter(cfg).Lint(schema)
+}
+
+func messages(findings []*errors.LintError) []string {
+	var result []string
+	for _, f := range findings {
+		result = append(result, f.Message)
+	}
+	return result
+}
+
+func TestLinter_NamingConvention(t *testing.T) {
+	input := `
+type Query { users(first_n: Int): [user] }
+type user { id: ID!, account_id: String }
+enum Role { admin, SUPER_USER }
+`
+	findings := lintSchema(t, input, map[string]string{config.LintNamingConvention: config.SeverityWarning})
+
+	assert.ElementsMatch(t, []string{
+		"argument name Query.users(first_n) should be camelCase",
+		`type name "user" should be PascalCase`,
+		"field name user.account_id should be camelCase",
+		"enum value Role.admin should be UPPER_SNAKE_CASE",
+	}, messages(findings))
+
+	for _, f := range findings {
+		assert.Equal(t, errors.CodeLint, f.Code)
+		assert.Equal(t, config.LintNamingConvention, f.Rule)
+		assert.Equal(t, config.SeverityWarning, f.Severity)
+		require.NotNil(t, f.Location)
+		assert.Equal(t, "schema.graphql", f.Location.File)
+	}
+}
+
+func TestLinter_MissingDescription(t *testing.T) {
+	input := `
+"Root"
+type Query {
+  "All users"
+  users: [User!]!
+}
+type User { id: ID! }
+`
+	findings := lintSchema(t, input, map[string]string{config.LintMissingDescription: config.SeverityInfo})
+
+	assert.Equal(t, []string{
+		"type User has no description",
+		"field User.id has no description",
+	}, messages(findings))
+}
+
+func TestLinter_UnusedType(t *testing.T) {
+	input := `
+type Query { node: Node, search: SearchResult }
+interface Node { id: ID! }
+type User implements Node { id: ID!, role: Role }
+enum Role { ADMIN }
+union SearchResult = Post
+type Post { title: String }
+type Orphan { id: ID! }
+input UnusedFilter { name: String }
+`
+	findings := lintSchema(t, input, map[string]string{config.LintUnusedType: config.SeverityWarning})
+
+	assert.Equal(t, []string{
+		"type Orphan is not reachable from the root operation types",
+		"type UnusedFilter is not reachable from the root operation types",
+	}, messages(findings))
+}
+
+func TestLinter_UnusedType_NoQueryType(t *testing.T) {
+	findings := lintSchema(t, `type User { id: ID! }`, map[string]string{config.LintUnusedType: config.SeverityWarning})
+	assert.Empty(t, findings)
+}
+
+func TestLinter_NullableListElement(t *testing.T) {
+	input := `
+type Query {
+  tags: [String]
+  names: [String!]!
+  matrix: [[Int!]]!
+  search(ids: [ID]): String
+}
+`
+	findings := lintSchema(t, input, map[string]string{config.LintNullableListElement: config.SeverityInfo})
+
+	assert.Equal(t, []string{
+		"list elements of Query.tags are nullable",
+		"list elements of Query.matrix are nullable",
+		"list elements of argument Query.search(ids) are nullable",
+	}, messages(findings))
+}
+
+func TestLinter_InconsistentID(t *testing.T) {
+	input := `
+type User { id: ID!, accountId: String, teamID: Int, owner_id: ID, org: Org, orgId: Org }
+type Org { id: ID! }
+`
+	findings := lintSchema(t, input, map[string]string{config.LintInconsistentID: config.SeverityWarning})
+
+	assert.Equal(t, []string{
+		"identifier field User.accountId has type String while other identifier fields use ID",
+		"identifier field User.teamID has type Int while other identifier fields use ID",
+	}, messages(findings))
+}
+
+func TestLinter_InconsistentID_NoIDUsage(t *testing.T) {
+	findings := lintSchema(t, `type User { id: Int!, accountId: String }`,
+		map[string]string{config.LintInconsistentID: config.SeverityWarning})
+	assert.Empty(t, findings)
+}
+
+func TestLinter_DirectiveArgumentType(t *testing.T) {
+	input := `
+directive @constraint(min: String, max: Int, email: Boolean, size: Int) on FIELD_DEFINITION
+directive @javaType(type: String!, imports: String) on FIELD_DEFINITION
+
+type User {
+  age: Int @constraint(min: "0", max: 150)
+  email: String @constraint(email: true, size: 3)
+  id: ID! @javaType(type: "java.util.UUID", imports: "java.util.UUID")
+}
+`
+	findings := lintSchema(t, input, map[string]string{config.LintDirectiveArgumentType: config.SeverityError})
+
+	assert.Equal(t, []string{
+		`argument "min" of @constraint must be Int`,
+		`@constraint has no argument "size"`,
+		`argument "imports" of @javaType must be [String]`,
+	}, messages(findings))
+	assert.True(t, HasErrors(findings))
+}
+
+func TestLinter_DirectiveMisuse(t *testing.T) {
+	input := `
+directive @lombok(exclude: [String]) on OBJECT | FIELD_DEFINITION
+directive @collection(type: String) on FIELD_DEFINITION
+directive @javaNme(name: String) on OBJECT
+
+type User @javaNme(name: "Account") @lombok(exclude: ["buidler"]) {
+  tags: [String] @collection(type: "Vector") @lombok(exclude: ["data"])
+}
+`
+	findings := lintSchema(t, input, map[string]string{config.LintDirectiveArgumentType: config.SeverityWarning})
+
+	assert.Equal(t, []string{
+		"@javaNme is not a gql2j directive",
+		`"buidler" is not a valid value for argument "exclude" of @lombok`,
+		`"Vector" is not a valid value for argument "type" of @collection`,
+		"@lombok cannot be used on FIELD_DEFINITION",
+	}, messages(findings))
+	assert.Equal(t, "did you mean @javaName?", findings[0].Hint)
+	assert.Equal(t, `did you mean "builder"?`, findings[1].Hint)
+	assert.Equal(t, "lombok", findings[1].Context["directive"])
+	assert.Equal(t, 7, findings[2].Location.Line)
+}
+
+func TestLinter_JavaNameIdentifier(t *testing.T) {
+	input := `
+directive @javaName(name: String!) on OBJECT | FIELD_DEFINITION | ENUM_VALUE
+
+type User @javaName(name: "Account") {
+  name: String @javaName(name: "class")
+  display: String @javaName(name: "display-name")
+  label: String @javaName(name: "été")
+  blank: String @javaName(name: "_")
+}
+
+enum Role { ADMIN @javaName(name: "1ST") }
+`
+	findings := lintSchema(t, input, map[string]string{config.LintJavaNameIdentifier: config.SeverityError})
+
+	assert.Equal(t, []string{
+		`@javaName "class" is a Java keyword`,
+		`@javaName "display-name" is not a valid Java identifier`,
+		`@javaName "_" is a Java keyword`,
+		`@javaName "1ST" is not a valid Java identifier`,
+	}, messages(findings))
+}
+
+func TestLinter_DefaultSeverities(t *testing.T) {
+	schema, err := parser.NewParser().Parse(`type Query { tags: [String] }`, "schema.graphql")
+	require.NoError(t, err)
+
+	findings := NewLinter(nil).Lint(schema)
+
+	severities := make(map[string]string)
+	for _, f := range findings {
+		severities[f.Rule] = f.Severity
+	}
+	assert.Equal(t, config.SeverityInfo, severities[config.LintNullableListElement])
+	assert.False(t, HasErrors(findings))
+}
+
+func TestLinter_SkipsFederationTypes(t *testing.T) {
+	input := `
+type Query { _service: _Service!, product: Product }
+type Product @key(fields: "id") { id: ID! }
+`
+	findings := lintSchema(t, input, map[string]string{
+		config.LintNamingConvention: config.SeverityWarning,
+		config.LintUnusedType:       config.SeverityWarning,
+	})
+	assert.Empty(t, findings)
+}
+
+func TestLinter_UnusedType_FederationEntities(t *testing.T) {
+	input := `
+type Query { product: Product }
+type Product @key(fields: "id") { id: ID! }
+type Review @key(fields: "id") { id: ID!, author: Author }
+type Author { name: String }
+type Orphan { id: ID! }
+`
+	findings := lintSchema(t, input, map[string]string{config.LintUnusedType: config.SeverityWarning})
+
+	assert.Equal(t, []string{
+		"type Orphan is not reachable from the root operation types",
+	}, messages(findings))
+}
@@ -0,0 +1,245 @@
+package lint
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/source-c/go-gql2j/internal/generator"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+var (
+	pascalCasePattern     = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	camelCasePattern      = regexp.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+	upperSnakeCasePattern = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+)
+
+// checkNamingConvention reports types that are not PascalCase, fields and
+// arguments that are not camelCase and enum values that are not UPPER_SNAKE_CASE.
+func checkNamingConvention(l *Linter, schema *parser.Schema, types []*parser.TypeDef) {
+	for _, t := range types {
+		if !pascalCasePattern.MatchString(t.Name) {
+			l.report(t.Location, t.Name, "", "type name %q should be PascalCase", t.Name)
+		}
+
+		for _, f := range lintedFields(schema, t) {
+			if !camelCasePattern.MatchString(f.Name) {
+				l.report(f.Location, t.Name, f.Name, "field name %s.%s should be camelCase", t.Name, f.Name)
+			}
+			for _, arg := range f.Arguments {
+				if !camelCasePattern.MatchString(arg.Name) {
+					l.report(arg.Location, t.Name, f.Name, "argument name %s.%s(%s) should be camelCase", t.Name, f.Name, arg.Name)
+				}
+			}
+		}
+
+		for _, ev := range t.EnumValues {
+			if !upperSnakeCasePattern.MatchString(ev.Name) {
+				l.report(ev.Location, t.Name, "", "enum value %s.%s should be UPPER_SNAKE_CASE", t.Name, ev.Name)
+			}
+		}
+	}
+}
+
+// checkMissingDescription reports types and fields without a description,
+// which become classes and fields without Javadoc.
+func checkMissingDescription(l *Linter, schema *parser.Schema, types []*parser.TypeDef) {
+	for _, t := range types {
+		if t.Description == "" {
+			l.report(t.Location, t.Name, "", "type %s has no description", t.Name)
+		}
+		for _, f := range lintedFields(schema, t) {
+			if f.Description == "" {
+				l.report(f.Location, t.Name, f.Name, "field %s.%s has no description", t.Name, f.Name)
+			}
+		}
+	}
+}
+
+// checkUnusedTypes reports types that cannot be reached from the root
+// operation types. Types implementing a reachable interface and members of a
+// reachable union count as reachable, and so do federation entities (types
+// with @key), which the router reaches through _entities. Schemas without a
+// query type are not checked, since they usually only define data types.
+func checkUnusedTypes(l *Linter, schema *parser.Schema, types []*parser.TypeDef) {
+	if schema.QueryType == "" {
+		return
+	}
+
+	implementers := make(map[string][]string)
+	for _, t := range types {
+		for _, iface := range t.Interfaces {
+			implementers[iface] = append(implementers[iface], t.Name)
+		}
+	}
+
+	reachable := make(map[string]bool)
+	queue := []string{schema.QueryType, schema.MutationType, schema.SubscriptionType}
+	for _, entity := range schema.EntityTypes() {
+		queue = append(queue, entity.Name)
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if name == "" || reachable[name] {
+			continue
+		}
+		reachable[name] = true
+
+		typeDef := schema.GetType(name)
+		if typeDef == nil {
+			continue
+		}
+		queue = append(queue, typeDef.ReferencedTypes()...)
+		queue = append(queue, implementers[name]...)
+	}
+
+	for _, t := range types {
+		if !reachable[t.Name] {
+			l.report(t.Location, t.Name, "", "type %s is not reachable from the root operation types", t.Name)
+		}
+	}
+}
+
+// checkNullableListElements reports list types with nullable elements, which
+// map to Java collections that may contain null.
+func checkNullableListElements(l *Linter, schema *parser.Schema, types []*parser.TypeDef) {
+	for _, t := range types {
+		for _, f := range lintedFields(schema, t) {
+			if hasNullableElements(f.Type) {
+				l.report(f.Location, t.Name, f.Name, "list elements of %s.%s are nullable", t.Name, f.Name)
+			}
+			for _, arg := range f.Arguments {
+				if hasNullableElements(arg.Type) {
+					l.report(arg.Location, t.Name, f.Name, "list elements of argument %s.%s(%s) are nullable", t.Name, f.Name, arg.Name)
+				}
+			}
+		}
+	}
+}
+
+func hasNullableElements(typeRef *parser.TypeRef) bool {
+	for typeRef != nil && typeRef.IsList() {
+		if typeRef.Elem == nil || !typeRef.Elem.NonNull {
+			return true
+		}
+		typeRef = typeRef.Elem
+	}
+	return false
+}
+
+// checkInconsistentIDs reports identifier fields (id, userId, user_id) that
+// use a scalar other than ID when other identifier fields use ID.
+func checkInconsistentIDs(l *Linter, schema *parser.Schema, types []*parser.TypeDef) {
+	type idField struct {
+		typeDef *parser.TypeDef
+		field   *parser.FieldDef
+		scalar  string
+	}
+
+	var fields []idField
+	usesID := false
+	for _, t := range types {
+		for _, f := range lintedFields(schema, t) {
+			if !isIdentifierName(f.Name) || f.Type == nil {
+				continue
+			}
+			scalar := f.Type.NamedType()
+			if target := schema.GetType(scalar); target != nil && target.Kind != parser.TypeKindScalar {
+				continue
+			}
+			if scalar == "ID" {
+				usesID = true
+			}
+			fields = append(fields, idField{typeDef: t, field: f, scalar: scalar})
+		}
+	}
+
+	if !usesID {
+		return
+	}
+	for _, f := range fields {
+		if f.scalar != "ID" {
+			l.report(f.field.Location, f.typeDef.Name, f.field.Name,
+				"identifier field %s.%s has type %s while other identifier fields use ID",
+				f.typeDef.Name, f.field.Name, f.scalar)
+		}
+	}
+}
+
+func isIdentifierName(name string) bool {
+	return name == "id" || strings.HasSuffix(name, "Id") ||
+		strings.HasSuffix(name, "ID") || strings.HasSuffix(name, "_id")
+}
+
+// checkDirectiveArgumentTypes reports gql2j directives that do not match
+// their signature: used where they have no effect, with unknown, mistyped or
+// missing arguments, with values outside the allowed set, or repeated. Such
+// usages are silently ignored during generation. Likely misspellings of gql2j
+// directive names are reported as well.
+func checkDirectiveArgumentTypes(l *Linter, schema *parser.Schema, types []*parser.TypeDef) {
+	check := func(directives []*parser.DirectiveDef, location ast.DirectiveLocation, typeName, fieldName string) {
+		for _, err := range parser.ValidateDirectives(directives, location) {
+			finding := l.report(err.Location, typeName, fieldName, "%s", err.Message)
+			finding.WithContext("directive", err.DirectiveName)
+			finding.WithHint(err.Hint)
+		}
+	}
+
+	for _, t := range types {
+		check(t.Directives, parser.TypeLocation(t.Kind), t.Name, "")
+		for _, f := range lintedFields(schema, t) {
+			check(f.Directives, parser.FieldLocation(t.Kind), t.Name, f.Name)
+		}
+		for _, ev := range t.EnumValues {
+			check(ev.Directives, ast.LocationEnumValue, t.Name, "")
+		}
+	}
+}
+
+// checkJavaNameIdentifiers reports @javaName values that are not valid Java
+// identifiers or are Java keywords.
+func checkJavaNameIdentifiers(l *Linter, schema *parser.Schema, types []*parser.TypeDef) {
+	check := func(directives []*parser.DirectiveDef, typeName, fieldName string) {
+		for _, d := range directives {
+			if d.Name != parser.DirectiveJavaName {
+				continue
+			}
+			name := d.GetArgumentString("name")
+			if !isJavaIdentifier(name) {
+				l.report(d.Location, typeName, fieldName, "@javaName %q is not a valid Java identifier", name)
+			} else if generator.IsJavaKeyword(name) {
+				l.report(d.Location, typeName, fieldName, "@javaName %q is a Java keyword", name)
+			}
+		}
+	}
+
+	for _, t := range types {
+		check(t.Directives, t.Name, "")
+		for _, f := range lintedFields(schema, t) {
+			check(f.Directives, t.Name, f.Name)
+		}
+		for _, ev := range t.EnumValues {
+			check(ev.Directives, t.Name, "")
+		}
+	}
+}
+
+func isJavaIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r == '_' || r == '$' || unicode.IsLetter(r) {
+			continue
+		}
+		if i > 0 && unicode.IsDigit(r) {
+			continue
+		}
+		return false
+	}
+	return true
+}
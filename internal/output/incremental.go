@@ -0,0 +1,109 @@
+package output
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/generator"
+)
+
+// IncrementalWriter writes only files whose content differs from what is
+// already on disk, so that repeated generation runs do not touch unchanged
+// files (and do not trigger rebuilds in IDEs or build tools).
+type IncrementalWriter struct {
+	*Writer
+	hashes map[string][sha256.Size]byte
+}
+
+// NewIncrementalWriter creates a new incremental file writer.
+func NewIncrementalWriter(outputDir string) *IncrementalWriter {
+	return &IncrementalWriter{
+		Writer: NewWriter(outputDir),
+		hashes: make(map[string][sha256.Size]byte),
+	}
+}
+
+// Write writes the files whose content changed. Unchanged files are reported
+// as skipped.
+func (w *IncrementalWriter) Write(files []*generator.GeneratedFile) *WriteResult {
+	result := &WriteResult{}
+
+	if err := w.EnsureDir(); err != nil {
+		result.Errors = append(result.Errors, err)
+		return result
+	}
+
+	for _, file := range files {
+		path := filepath.Join(w.outputDir, file.FileName)
+		sum := sha256.Sum256([]byte(file.Content))
+
+		if w.isUnchanged(path, sum) {
+			result.Skipped = append(result.Skipped, path)
+			continue
+		}
+
+		// Files such as module-info.java may be outside the output directory
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			result.Errors = append(result.Errors,
+				errors.NewOutputError("failed to create directory", err).
+					WithFilePath(path))
+			continue
+		}
+
+		if err := os.WriteFile(path, []byte(file.Content), 0644); err != nil {
+			result.Errors = append(result.Errors,
+				errors.NewOutputError("failed to write file", err).
+					WithFilePath(path))
+			continue
+		}
+
+		w.hashes[path] = sum
+		result.Written = append(result.Written, path)
+	}
+
+	return result
+}
+
+// Remove deletes previously generated files, ignoring files that do not exist.
+func (w *IncrementalWriter) Remove(fileNames []string) *WriteResult {
+	result := &WriteResult{}
+
+	for _, name := range fileNames {
+		path := filepath.Join(w.outputDir, name)
+		if err := os.Remove(path); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			result.Errors = append(result.Errors,
+				errors.NewOutputError("failed to remove file", err).
+					WithFilePath(path))
+			continue
+		}
+		delete(w.hashes, path)
+		result.Removed = append(result.Removed, path)
+	}
+
+	return result
+}
+
+func (w *IncrementalWriter) isUnchanged(path string, sum [sha256.Size]byte) bool {
+	if known, ok := w.hashes[path]; ok {
+		if known != sum {
+			return false
+		}
+		// Make sure the file was not deleted behind our back
+		_, err := os.Stat(path)
+		return err == nil
+	}
+
+	// First time we see this file: compare with the content on disk
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	existing := sha256.Sum256(data)
+	w.hashes[path] = existing
+	return existing == sum
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/generator"
+)
+
+func TestIncrementalWriter_SkipsUnchangedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	w := NewIncrementalWriter(tmpDir)
+
+	files := []*generator.GeneratedFile{
+		{FileName: "User.java", Content: "public class User {}"},
+		{FileName: "Post.java", Content: "public class Post {}"},
+	}
+
+	result := w.Write(files)
+	require.Empty(t, result.Errors)
+	assert.Len(t, result.Written, 2)
+
+	files[1].Content = "public class Post { String title; }"
+	result = w.Write(files)
+	require.Empty(t, result.Errors)
+	assert.Equal(t, []string{filepath.Join(tmpDir, "Post.java")}, result.Written)
+	assert.Equal(t, []string{filepath.Join(tmpDir, "User.java")}, result.Skipped)
+}
+
+func TestIncrementalWriter_ComparesWithExistingFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "User.java")
+	require.NoError(t, os.WriteFile(path, []byte("public class User {}"), 0644))
+
+	w := NewIncrementalWriter(tmpDir)
+	result := w.Write([]*generator.GeneratedFile{
+		{FileName: "User.java", Content: "public class User {}"},
+	})
+
+	assert.Empty(t, result.Written)
+	assert.Equal(t, []string{path}, result.Skipped)
+}
+
+func TestIncrementalWriter_RewritesDeletedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	w := NewIncrementalWriter(tmpDir)
+	files := []*generator.GeneratedFile{
+		{FileName: "User.java", Content: "public class User {}"},
+	}
+
+	w.Write(files)
+	require.NoError(t, os.Remove(filepath.Join(tmpDir, "User.java")))
+
+	result := w.Write(files)
+	assert.Len(t, result.Written, 1)
+}
+
+func TestIncrementalWriter_Remove(t *testing.T) {
+	tmpDir := t.TempDir()
+	w := NewIncrementalWriter(tmpDir)
+	w.Write([]*generator.GeneratedFile{
+		{FileName: "User.java", Content: "public class User {}"},
+	})
+
+	result := w.Remove([]string{"User.java", "Missing.java"})
+
+	require.Empty(t, result.Errors)
+	assert.Equal(t, []string{filepath.Join(tmpDir, "User.java")}, result.Removed)
+	_, err := os.Stat(filepath.Join(tmpDir, "User.java"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -58,6 +58,12 @@ func (w *Writer) WriteFile(file *generator.GeneratedFile) error {
 		}
 	}
 
+	// Files such as module-info.java may be outside the output directory
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.NewOutputError("failed to create directory", err).
+			WithFilePath(path)
+	}
+
 	// Write the file
 	if err := os.WriteFile(path, []byte(file.Content), 0644); err != nil {
 		return errors.NewOutputError("failed to write file", err).
@@ -72,7 +78,7 @@ func (w *Writer) GetOutputPath(fileName string) string {
 	return filepath.Join(w.outputDir, fileName)
 }
 
-// Clean removes all Java files from the output directory.
+// Clean removes all Java and Kotlin files from the output directory.
 func (w *Writer) Clean() error {
 	entries, err := os.ReadDir(w.outputDir)
 	if err != nil {
@@ -89,7 +95,7 @@ func (w *Writer) Clean() error {
 		if entry.IsDir() {
 			continue
 		}
-		if filepath.Ext(entry.Name()) == ".java" {
+		if ext := filepath.Ext(entry.Name()); ext == ".java" || ext == ".kt" {
 			path := filepath.Join(w.outputDir, entry.Name())
 			if err := os.Remove(path); err != nil {
 				errs.Add(errors.NewOutputError("failed to remove file", err).
@@ -120,6 +126,7 @@ func (w *Writer) EnsureDir() error {
 type WriteResult struct {
 	Written []string
 	Skipped []string
+	Removed []string
 	Errors  []error
 }
 
@@ -146,6 +153,14 @@ func (w *Writer) WriteAllWithResult(files []*generator.GeneratedFile) *WriteResu
 			}
 		}
 
+		// Files such as module-info.java may be outside the output directory
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			result.Errors = append(result.Errors,
+				errors.NewOutputError("failed to create directory", err).
+					WithFilePath(path))
+			continue
+		}
+
 		// Write the file
 		if err := os.WriteFile(path, []byte(file.Content), 0644); err != nil {
 			result.Errors = append(result.Errors,
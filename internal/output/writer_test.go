@@ -184,6 +184,8 @@ func TestWriter_Clean(t *testing.T) {
 	require.NoError(t, err)
 	err = os.WriteFile(filepath.Join(tmpDir, "Post.java"), []byte("class Post {}"), 0644)
 	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "Tag.kt"), []byte("class Tag"), 0644)
+	require.NoError(t, err)
 	// Create a non-Java file
 	err = os.WriteFile(filepath.Join(tmpDir, "readme.txt"), []byte("readme"), 0644)
 	require.NoError(t, err)
@@ -198,6 +200,9 @@ func TestWriter_Clean(t *testing.T) {
 	_, err = os.Stat(filepath.Join(tmpDir, "Post.java"))
 	assert.True(t, os.IsNotExist(err))
 
+	_, err = os.Stat(filepath.Join(tmpDir, "Tag.kt"))
+	assert.True(t, os.IsNotExist(err))
+
 	// Non-Java file should remain
 	_, err = os.Stat(filepath.Join(tmpDir, "readme.txt"))
 	assert.NoError(t, err)
@@ -232,3 +237,20 @@ func TestWriter_EnsureDir(t *testing.T) {
 
 	assert.True(t, w.Exists())
 }
+
+func TestWriter_WriteAllWithResult_OutsideOutputDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputDir := filepath.Join(tmpDir, "com", "example")
+	w := NewWriter(outputDir)
+
+	result := w.WriteAllWithResult([]*generator.GeneratedFile{
+		{FileName: "User.java", Content: "package com.example;"},
+		{FileName: filepath.Join("..", "..", "module-info.java"), Content: "module com.example {}"},
+	})
+	require.Empty(t, result.Errors)
+	assert.Len(t, result.Written, 2)
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "module-info.java"))
+	require.NoError(t, err)
+	assert.Equal(t, "module com.example {}", string(content))
+}
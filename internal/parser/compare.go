@@ -0,0 +1,134 @@
+package parser
+
+import (
+	"reflect"
+)
+
+// SameAs reports whether two type definitions are identical apart from
+// source locations. It is used to detect which types changed between two
+// parses of the same schema.
+func (t *TypeDef) SameAs(other *TypeDef) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+
+	if t.Name != other.Name || t.Kind != other.Kind || t.Description != other.Description {
+		return false
+	}
+	if !equalStrings(t.Interfaces, other.Interfaces) || !equalStrings(t.PossibleTypes, other.PossibleTypes) {
+		return false
+	}
+	if !sameDirectives(t.Directives, other.Directives) {
+		return false
+	}
+
+	if len(t.Fields) != len(other.Fields) {
+		return false
+	}
+	for i := range t.Fields {
+		if !t.Fields[i].SameAs(other.Fields[i]) {
+			return false
+		}
+	}
+
+	if len(t.EnumValues) != len(other.EnumValues) {
+		return false
+	}
+	for i := range t.EnumValues {
+		a, b := t.EnumValues[i], other.EnumValues[i]
+		if a.Name != b.Name || a.Description != b.Description || !sameDirectives(a.Directives, b.Directives) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SameAs reports whether two field definitions are identical apart from
+// source locations.
+func (f *FieldDef) SameAs(other *FieldDef) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+
+	if f.Name != other.Name || f.Description != other.Description {
+		return false
+	}
+	if !reflect.DeepEqual(f.Type, other.Type) || !reflect.DeepEqual(f.DefaultValue, other.DefaultValue) {
+		return false
+	}
+	if !sameDirectives(f.Directives, other.Directives) {
+		return false
+	}
+
+	if len(f.Arguments) != len(other.Arguments) {
+		return false
+	}
+	for i := range f.Arguments {
+		a, b := f.Arguments[i], other.Arguments[i]
+		if a.Name != b.Name || a.Description != b.Description ||
+			!reflect.DeepEqual(a.Type, b.Type) || !reflect.DeepEqual(a.DefaultValue, b.DefaultValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameDirectives(a, b []*DirectiveDef) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || !reflect.DeepEqual(a[i].Arguments, b[i].Arguments) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReferencedTypes returns the names of all types the type definition refers
+// to through field types, argument types, implemented interfaces and union
+// members.
+func (t *TypeDef) ReferencedTypes() []string {
+	seen := make(map[string]bool)
+	var result []string
+
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+
+	for _, iface := range t.Interfaces {
+		add(iface)
+	}
+	for _, member := range t.PossibleTypes {
+		add(member)
+	}
+	for _, field := range t.Fields {
+		if field.Type != nil {
+			add(field.Type.NamedType())
+		}
+		for _, arg := range field.Arguments {
+			if arg.Type != nil {
+				add(arg.Type.NamedType())
+			}
+		}
+	}
+
+	return result
+}
@@ -1,9 +1,9 @@
 package parser
 
 import (
-	"github.com/vektah/gqlparser/v2/ast"
+	"sort"
 
-	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 // Supported directive names.
@@ -16,10 +16,82 @@ const (
 	DirectiveConstraint = "constraint"
 	DirectiveLombok     = "lombok"
 	DirectiveCollection = "collection"
+	DirectiveOneOf      = "oneOf"
+	DirectiveEntity     = "entity"
+	DirectiveID         = "id"
+	DirectiveColumn     = "column"
+	DirectiveRelation   = "relation"
+	DirectiveTransient  = "transient"
+)
+
+// Relationship kinds of @relation.
+const (
+	RelationOneToOne   = "ONE_TO_ONE"
+	RelationOneToMany  = "ONE_TO_MANY"
+	RelationManyToOne  = "MANY_TO_ONE"
+	RelationManyToMany = "MANY_TO_MANY"
 )
 
+// ArgumentKind is the expected type of a gql2j directive argument.
+type ArgumentKind string
+
+// Argument kinds of the gql2j directives.
+const (
+	ArgumentString     ArgumentKind = "String"
+	ArgumentInt        ArgumentKind = "Int"
+	ArgumentBoolean    ArgumentKind = "Boolean"
+	ArgumentStringList ArgumentKind = "[String]"
+)
+
+// Matches returns true if an argument value, as stored in
+// DirectiveDef.Arguments, has this kind.
+func (k ArgumentKind) Matches(value interface{}) bool {
+	switch k {
+	case ArgumentString:
+		_, ok := value.(string)
+		return ok
+	case ArgumentInt:
+		_, ok := value.(int64)
+		return ok
+	case ArgumentBoolean:
+		_, ok := value.(bool)
+		return ok
+	case ArgumentStringList:
+		list, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			if _, ok := item.(string); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// IgnoredArguments returns the sorted names of the arguments of a gql2j
+// directive that are unknown or have the wrong type. It returns nil for
+// directives that are not gql2j directives.
+func (d *DirectiveDef) IgnoredArguments() []string {
+	signature := LookupDirective(d.Name)
+	if signature == nil {
+		return nil
+	}
+
+	var ignored []string
+	for name, value := range d.Arguments {
+		if arg := signature.Argument(name); arg == nil || !arg.Kind.Matches(value) {
+			ignored = append(ignored, name)
+		}
+	}
+	sort.Strings(ignored)
+	return ignored
+}
+
 // extractDirectives converts AST directives to our DirectiveDef format.
-func extractDirectives(astDirectives ast.DirectiveList, source string) []*DirectiveDef {
+func extractDirectives(astDirectives ast.DirectiveList) []*DirectiveDef {
 	if len(astDirectives) == 0 {
 		return nil
 	}
@@ -29,13 +101,7 @@ func extractDirectives(astDirectives ast.DirectiveList, source string) []*Direct
 		directive := &DirectiveDef{
 			Name:      d.Name,
 			Arguments: extractDirectiveArguments(d.Arguments),
-		}
-		if d.Position != nil {
-			directive.Location = &errors.Location{
-				File:   source,
-				Line:   d.Position.Line,
-				Column: d.Position.Column,
-			}
+			Location:  convertPosition(d.Position),
 		}
 		directives = append(directives, directive)
 	}
@@ -121,10 +187,14 @@ type SkipDirectiveInfo struct {
 	Applied bool
 }
 
-// ExtractSkipDirective extracts @skip directive info.
+// ExtractSkipDirective extracts @skip directive info. @skip(if: false) is
+// treated as absent.
 func ExtractSkipDirective(directives []*DirectiveDef) *SkipDirectiveInfo {
 	for _, d := range directives {
 		if d.Name == DirectiveSkip {
+			if skip, ok := d.GetArgumentBool("if"); ok && !skip {
+				continue
+			}
 			return &SkipDirectiveInfo{Applied: true}
 		}
 	}
@@ -215,12 +285,32 @@ func ExtractAnnotationDirectives(directives []*DirectiveDef) []*AnnotationDirect
 type ConstraintDirectiveInfo struct {
 	MinLength *int
 	MaxLength *int
+	MinItems  *int
+	MaxItems  *int
 	Min       *int
 	Max       *int
-	Pattern   string
-	NotNull   bool
-	NotBlank  bool
-	Email     bool
+	// DecimalMin and DecimalMax are decimal numbers, e.g. "0.01".
+	DecimalMin      string
+	DecimalMax      string
+	Positive        bool
+	PositiveOrZero  bool
+	Negative        bool
+	NegativeOrZero  bool
+	IntegerDigits   *int
+	FractionDigits  *int
+	Past            bool
+	PastOrPresent   bool
+	Future          bool
+	FutureOrPresent bool
+	Pattern         string
+	PatternFlags    []string
+	NotNull         bool
+	NotBlank        bool
+	NotEmpty        bool
+	Email           bool
+	// Message and Groups apply to every constraint of the directive.
+	Message string
+	Groups  []string
 }
 
 // ExtractConstraintDirective extracts @constraint directive info.
@@ -228,28 +318,44 @@ func ExtractConstraintDirective(directives []*DirectiveDef) *ConstraintDirective
 	for _, d := range directives {
 		if d.Name == DirectiveConstraint {
 			info := &ConstraintDirectiveInfo{}
-			if v, ok := d.GetArgumentInt("minLength"); ok {
-				info.MinLength = &v
-			}
-			if v, ok := d.GetArgumentInt("maxLength"); ok {
-				info.MaxLength = &v
-			}
-			if v, ok := d.GetArgumentInt("min"); ok {
-				info.Min = &v
+			for name, target := range map[string]**int{
+				"minLength":      &info.MinLength,
+				"maxLength":      &info.MaxLength,
+				"minItems":       &info.MinItems,
+				"maxItems":       &info.MaxItems,
+				"min":            &info.Min,
+				"max":            &info.Max,
+				"integerDigits":  &info.IntegerDigits,
+				"fractionDigits": &info.FractionDigits,
+			} {
+				if v, ok := d.GetArgumentInt(name); ok {
+					*target = &v
+				}
 			}
-			if v, ok := d.GetArgumentInt("max"); ok {
-				info.Max = &v
+			for name, target := range map[string]*bool{
+				"positive":        &info.Positive,
+				"positiveOrZero":  &info.PositiveOrZero,
+				"negative":        &info.Negative,
+				"negativeOrZero":  &info.NegativeOrZero,
+				"past":            &info.Past,
+				"pastOrPresent":   &info.PastOrPresent,
+				"future":          &info.Future,
+				"futureOrPresent": &info.FutureOrPresent,
+				"notNull":         &info.NotNull,
+				"notBlank":        &info.NotBlank,
+				"notEmpty":        &info.NotEmpty,
+				"email":           &info.Email,
+			} {
+				if v, ok := d.GetArgumentBool(name); ok {
+					*target = v
+				}
 			}
+			info.DecimalMin = d.GetArgumentString("decimalMin")
+			info.DecimalMax = d.GetArgumentString("decimalMax")
 			info.Pattern = d.GetArgumentString("pattern")
-			if v, ok := d.GetArgumentBool("notNull"); ok {
-				info.NotNull = v
-			}
-			if v, ok := d.GetArgumentBool("notBlank"); ok {
-				info.NotBlank = v
-			}
-			if v, ok := d.GetArgumentBool("email"); ok {
-				info.Email = v
-			}
+			info.PatternFlags = d.GetArgumentStringSlice("patternFlags")
+			info.Message = d.GetArgumentString("message")
+			info.Groups = d.GetArgumentStringSlice("groups")
 			return info
 		}
 	}
@@ -292,3 +398,58 @@ func ExtractCollectionDirective(directives []*DirectiveDef) *CollectionDirective
 	}
 	return nil
 }
+
+// EntityDirectiveInfo extracts information from @entity directive.
+type EntityDirectiveInfo struct {
+	Table string
+}
+
+// ExtractEntityDirective extracts @entity directive info.
+func ExtractEntityDirective(directives []*DirectiveDef) *EntityDirectiveInfo {
+	for _, d := range directives {
+		if d.Name == DirectiveEntity {
+			return &EntityDirectiveInfo{Table: d.GetArgumentString("table")}
+		}
+	}
+	return nil
+}
+
+// ColumnDirectiveInfo extracts information from @column directive.
+type ColumnDirectiveInfo struct {
+	Name string
+	// Length is the column length, 0 if not set.
+	Length int
+}
+
+// ExtractColumnDirective extracts @column directive info.
+func ExtractColumnDirective(directives []*DirectiveDef) *ColumnDirectiveInfo {
+	for _, d := range directives {
+		if d.Name == DirectiveColumn {
+			length, _ := d.GetArgumentInt("length")
+			return &ColumnDirectiveInfo{
+				Name:   d.GetArgumentString("name"),
+				Length: length,
+			}
+		}
+	}
+	return nil
+}
+
+// RelationDirectiveInfo extracts information from @relation directive.
+type RelationDirectiveInfo struct {
+	Kind     string
+	MappedBy string
+}
+
+// ExtractRelationDirective extracts @relation directive info.
+func ExtractRelationDirective(directives []*DirectiveDef) *RelationDirectiveInfo {
+	for _, d := range directives {
+		if d.Name == DirectiveRelation {
+			return &RelationDirectiveInfo{
+				Kind:     d.GetArgumentString("kind"),
+				MappedBy: d.GetArgumentString("mappedBy"),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package parser
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Apollo Federation directive names.
+const (
+	DirectiveKey          = "key"
+	DirectiveExternal     = "external"
+	DirectiveRequires     = "requires"
+	DirectiveProvides     = "provides"
+	DirectiveShareable    = "shareable"
+	DirectiveInaccessible = "inaccessible"
+	DirectiveLink         = "link"
+)
+
+// federationSourceName is the source name of the injected federation definitions.
+const federationSourceName = "<federation>"
+
+// federationDirectives are the definitions of the Apollo Federation (v1 and v2)
+// directives, keyed by name. Subgraph schemas use them without declaring them.
+var federationDirectives = map[string]string{
+	DirectiveKey:          `directive @key(fields: FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE`,
+	DirectiveExternal:     `directive @external(reason: String) on OBJECT | FIELD_DEFINITION`,
+	DirectiveRequires:     `directive @requires(fields: FieldSet!) on FIELD_DEFINITION`,
+	DirectiveProvides:     `directive @provides(fields: FieldSet!) on FIELD_DEFINITION`,
+	DirectiveShareable:    `directive @shareable repeatable on OBJECT | FIELD_DEFINITION`,
+	DirectiveInaccessible: `directive @inaccessible on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION`,
+	DirectiveLink:         `directive @link(url: String!, as: String, for: link__Purpose, import: [link__Import]) repeatable on SCHEMA`,
+	"override":            `directive @override(from: String!) on FIELD_DEFINITION`,
+	"tag":                 `directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION`,
+	"extends":             `directive @extends on OBJECT | INTERFACE`,
+	"interfaceObject":     `directive @interfaceObject on OBJECT`,
+	"composeDirective":    `directive @composeDirective(name: String!) repeatable on SCHEMA`,
+}
+
+// federationTypes are the definitions of the federation scalars and types,
+// keyed by name. They are infrastructure types and are never generated.
+var federationTypes = map[string]string{
+	"_Any":          `scalar _Any`,
+	"FieldSet":      `scalar FieldSet`,
+	"_FieldSet":     `scalar _FieldSet`,
+	"link__Import":  `scalar link__Import`,
+	"link__Purpose": `enum link__Purpose { SECURITY EXECUTION }`,
+	"_Service":      `type _Service { sdl: String }`,
+	"_Entity":       `scalar _Entity`,
+}
+
+// IsFederationType returns true if the type is an Apollo Federation
+// infrastructure type (_Any, FieldSet, link__Import, _Service, ...).
+func IsFederationType(name string) bool {
+	_, ok := federationTypes[name]
+	return ok
+}
+
+// IsFederationQueryField returns true if the root query field is one of the
+// fields added by federation (_service, _entities).
+func IsFederationQueryField(name string) bool {
+	return name == "_service" || name == "_entities"
+}
+
+// IsFederationDirective returns true if the directive is an Apollo Federation directive.
+func IsFederationDirective(name string) bool {
+	_, ok := federationDirectives[name]
+	return ok
+}
+
+// federationSource returns a source with the federation definitions that the
+// schema uses but does not declare, or nil if the schema does not use
+// federation. Definitions the schema declares itself are left alone, so
+// subgraphs that stub the federation spec keep working.
+func federationSource(doc *ast.SchemaDocument) *ast.Source {
+	declared := make(map[string]bool)
+	for _, d := range doc.Directives {
+		declared["@"+d.Name] = true
+	}
+	for _, def := range doc.Definitions {
+		declared[def.Name] = true
+	}
+
+	used := make(map[string]bool)
+	collectFederationUsage(doc, used)
+
+	usesFederation := false
+	for name := range used {
+		if !declared[name] {
+			usesFederation = true
+			break
+		}
+	}
+	if !usesFederation {
+		return nil
+	}
+
+	var sb strings.Builder
+	for _, name := range sortedKeys(federationDirectives) {
+		if !declared["@"+name] {
+			sb.WriteString(federationDirectives[name])
+			sb.WriteString("\n")
+		}
+	}
+	for _, name := range sortedKeys(federationTypes) {
+		if !declared[name] {
+			sb.WriteString(federationTypes[name])
+			sb.WriteString("\n")
+		}
+	}
+
+	return &ast.Source{Name: federationSourceName, Input: sb.String()}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// collectFederationUsage records the federation directives ("@key") and
+// federation types ("_Any") referenced anywhere in the document.
+func collectFederationUsage(doc *ast.SchemaDocument, used map[string]bool) {
+	addDirectives := func(list ast.DirectiveList) {
+		for _, d := range list {
+			if IsFederationDirective(d.Name) {
+				used["@"+d.Name] = true
+			}
+		}
+	}
+	addType := func(t *ast.Type) {
+		for t != nil && t.Elem != nil {
+			t = t.Elem
+		}
+		if t != nil && IsFederationType(t.NamedType) {
+			used[t.NamedType] = true
+		}
+	}
+
+	for _, s := range append(doc.Schema, doc.SchemaExtension...) {
+		addDirectives(s.Directives)
+	}
+
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		addDirectives(def.Directives)
+		for _, field := range def.Fields {
+			addDirectives(field.Directives)
+			addType(field.Type)
+			for _, arg := range field.Arguments {
+				addDirectives(arg.Directives)
+				addType(arg.Type)
+			}
+		}
+		for _, ev := range def.EnumValues {
+			addDirectives(ev.Directives)
+		}
+	}
+}
+
+// KeyDirectiveInfo extracts information from an Apollo Federation @key directive.
+type KeyDirectiveInfo struct {
+	Fields     string
+	Resolvable bool
+}
+
+// FieldNames returns the top-level field names of the key's field set.
+// Nested selections such as "organization { id }" yield "organization".
+func (k *KeyDirectiveInfo) FieldNames() []string {
+	var names []string
+	depth := 0
+	for _, token := range strings.FieldsFunc(k.Fields, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\n' || r == '\t'
+	}) {
+		for len(token) > 0 {
+			switch {
+			case token[0] == '{':
+				depth++
+				token = token[1:]
+			case token[0] == '}':
+				depth--
+				token = token[1:]
+			default:
+				end := strings.IndexAny(token, "{}")
+				if end < 0 {
+					end = len(token)
+				}
+				if depth == 0 {
+					names = append(names, token[:end])
+				}
+				token = token[end:]
+			}
+		}
+	}
+	return names
+}
+
+// ExtractKeyDirectives extracts all @key directives of an entity type.
+func ExtractKeyDirectives(directives []*DirectiveDef) []*KeyDirectiveInfo {
+	var result []*KeyDirectiveInfo
+	for _, d := range directives {
+		if d.Name == DirectiveKey {
+			fields := d.GetArgumentString("fields")
+			if fields == "" {
+				continue
+			}
+			resolvable := true
+			if v, ok := d.GetArgumentBool("resolvable"); ok {
+				resolvable = v
+			}
+			result = append(result, &KeyDirectiveInfo{Fields: fields, Resolvable: resolvable})
+		}
+	}
+	return result
+}
+
+// EntityTypes returns the object types that declare at least one @key,
+// sorted by name.
+func (s *Schema) EntityTypes() []*TypeDef {
+	var result []*TypeDef
+	for _, t := range s.ObjectTypes() {
+		if t.HasDirective(DirectiveKey) {
+			result = append(result, t)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
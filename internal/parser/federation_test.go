@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_ParseFile_FederationSubgraph(t *testing.T) {
+	p := NewParser()
+	schema, err := p.ParseFile(filepath.Join("..", "..", "testdata", "schemas", "federation.graphql"))
+	require.NoError(t, err)
+
+	product := schema.GetType("Product")
+	require.NotNil(t, product)
+	assert.True(t, product.HasDirective(DirectiveKey))
+	assert.True(t, findField(product.Fields, "weight").HasDirective(DirectiveExternal))
+	assert.True(t, findField(product.Fields, "internalCode").HasDirective(DirectiveInaccessible))
+
+	// The injected federation definitions are recognized as federation types
+	anyType := schema.GetType("_Any")
+	require.NotNil(t, anyType)
+	assert.True(t, IsFederationType(anyType.Name))
+	assert.Equal(t, federationSourceName, anyType.Location.File)
+	assert.NotNil(t, schema.Directives[DirectiveKey])
+}
+
+func TestParser_Parse_FederationV1(t *testing.T) {
+	input := `
+type Query {
+  _service: _Service!
+  _entities(representations: [_Any!]!): [_Entity]!
+  me: User
+}
+
+extend type User @key(fields: "id") {
+  id: ID! @external
+  reviews: [String]
+}
+`
+	p := NewParser()
+	schema, err := p.Parse(input, "subgraph.graphql")
+	require.NoError(t, err)
+
+	user := schema.GetType("User")
+	require.NotNil(t, user)
+	assert.Len(t, schema.EntityTypes(), 1)
+}
+
+func TestParser_Parse_FederationDeclaredDefinitionsKept(t *testing.T) {
+	input := `
+scalar FieldSet
+directive @key(fields: FieldSet!) on OBJECT
+
+type User @key(fields: "id") {
+  id: ID!
+}
+`
+	p := NewParser()
+	schema, err := p.Parse(input, "subgraph.graphql")
+	require.NoError(t, err)
+
+	// The schema's own declarations are used, nothing else is injected
+	assert.Equal(t, "subgraph.graphql", schema.GetType("FieldSet").Location.File)
+	assert.Nil(t, schema.GetType("_Any"))
+}
+
+func TestParser_Parse_NoFederation(t *testing.T) {
+	p := NewParser()
+	schema, err := p.Parse(`type Query { hello: String }`, "schema.graphql")
+	require.NoError(t, err)
+
+	assert.Nil(t, schema.GetType("_Any"))
+	assert.Nil(t, schema.Directives[DirectiveKey])
+}
+
+func TestKeyDirectiveInfo_FieldNames(t *testing.T) {
+	tests := []struct {
+		fields   string
+		expected []string
+	}{
+		{"id", []string{"id"}},
+		{"sku package", []string{"sku", "package"}},
+		{"id organization { id }", []string{"id", "organization"}},
+		{"upc,owner{ id name { first } } version", []string{"upc", "owner", "version"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fields, func(t *testing.T) {
+			key := &KeyDirectiveInfo{Fields: tt.fields}
+			assert.Equal(t, tt.expected, key.FieldNames())
+		})
+	}
+}
+
+func TestExtractKeyDirectives(t *testing.T) {
+	directives := []*DirectiveDef{
+		{Name: DirectiveKey, Arguments: map[string]interface{}{"fields": "id"}},
+		{Name: DirectiveKey, Arguments: map[string]interface{}{"fields": "sku", "resolvable": false}},
+		{Name: DirectiveShareable},
+	}
+
+	keys := ExtractKeyDirectives(directives)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "id", keys[0].Fields)
+	assert.True(t, keys[0].Resolvable)
+	assert.Equal(t, "sku", keys[1].Fields)
+	assert.False(t, keys[1].Resolvable)
+}
@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+)
+
+var (
+	undefinedTypePattern      = regexp.MustCompile(`^Undefined type ("[^"]+"|\S+)\.$`)
+	undefinedRootTypePattern  = regexp.MustCompile(`^Schema root \S+ refers to a type (\S+) that does not exist\.$`)
+	undefinedDirectivePattern = regexp.MustCompile(`^Undefined directive (\S+)\.$`)
+	undefinedArgumentPattern  = regexp.MustCompile(`^Undefined argument (\S+) for directive (\S+)\.$`)
+)
+
+// schemaHint suggests a fix for a schema validation error, e.g. the closest
+// defined type for an undefined one. The document holds the definitions of
+// the schema and those injected into it, and is nil if the schema has syntax
+// errors. It returns "" if there is no suggestion.
+func schemaHint(message string, doc *ast.SchemaDocument) string {
+	if doc == nil {
+		return ""
+	}
+
+	if m := undefinedTypePattern.FindStringSubmatch(message); m != nil {
+		return didYouMean(unquote(m[1]), typeNames(doc), "")
+	}
+	if m := undefinedRootTypePattern.FindStringSubmatch(message); m != nil {
+		return didYouMean(m[1], typeNames(doc), "")
+	}
+	if m := undefinedDirectivePattern.FindStringSubmatch(message); m != nil {
+		if LookupDirective(m[1]) != nil {
+			return "declare the gql2j directives in the schema; gql2j directives prints their definitions"
+		}
+		return didYouMean(m[1], directiveNames(doc), "@")
+	}
+	if m := undefinedArgumentPattern.FindStringSubmatch(message); m != nil {
+		for _, d := range doc.Directives {
+			if d.Name == m[2] {
+				var args []string
+				for _, arg := range d.Arguments {
+					args = append(args, arg.Name)
+				}
+				return didYouMean(m[1], args, "")
+			}
+		}
+	}
+	return ""
+}
+
+func didYouMean(name string, candidates []string, prefix string) string {
+	if match := errors.ClosestMatch(name, candidates); match != "" {
+		return "did you mean " + prefix + match + "?"
+	}
+	return ""
+}
+
+func unquote(s string) string {
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	return s
+}
+
+// typeNames returns the types defined in the document and the built-in scalars.
+func typeNames(doc *ast.SchemaDocument) []string {
+	names := []string{"String", "Int", "Float", "Boolean", "ID"}
+	for _, def := range doc.Definitions {
+		names = append(names, def.Name)
+	}
+	return names
+}
+
+// directiveNames returns the directives declared in the document, the
+// built-in directives and the gql2j directives, which must be declared
+// before use.
+func directiveNames(doc *ast.SchemaDocument) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, d := range doc.Directives {
+		add(d.Name)
+	}
+	for _, name := range []string{"deprecated", "specifiedBy", "include", "skip"} {
+		add(name)
+	}
+	for _, s := range directiveSignatures {
+		add(s.Name)
+	}
+	return names
+}
@@ -0,0 +1,34 @@
+package parser
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	gqlparser "github.com/vektah/gqlparser/v2/parser"
+)
+
+// injectDefinitions adds sources with the definitions the schema uses
+// without declaring them: those of Apollo Federation, which subgraphs use
+// without declaring them, @skip on schema elements, a gql2j extension of the
+// built-in directive, and @oneOf, which gqlparser does not know yet. The
+// schema is parsed once to find them. It also returns the parsed document
+// with the injected definitions, or nil if the schema has syntax errors,
+// which the real parse reports.
+func injectDefinitions(sources []*ast.Source) ([]*ast.Source, *ast.SchemaDocument) {
+	doc, err := gqlparser.ParseSchemas(sources...)
+	if err != nil {
+		return sources, nil
+	}
+
+	var injected []*ast.Source
+	for _, source := range []*ast.Source{federationSource(doc), skipSource(doc), oneOfSource(doc)} {
+		if source != nil {
+			injected = append(injected, source)
+		}
+	}
+	for _, source := range injected {
+		if injectedDoc, err := gqlparser.ParseSchema(source); err == nil {
+			doc.Merge(injectedDoc)
+		}
+	}
+
+	return append(sources[:len(sources):len(sources)], injected...), doc
+}
@@ -0,0 +1,312 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	gqlparser "github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+)
+
+// introspectionResult is a standard introspection query response.
+// Both the full response ({"data": {"__schema": ...}}) and a bare
+// {"__schema": ...} object are accepted.
+type introspectionResult struct {
+	Data *struct {
+		Schema *introspectionSchema `json:"__schema"`
+	} `json:"data"`
+	Schema *introspectionSchema `json:"__schema"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type introspectionSchema struct {
+	QueryType        *introspectionNamed       `json:"queryType"`
+	MutationType     *introspectionNamed       `json:"mutationType"`
+	SubscriptionType *introspectionNamed       `json:"subscriptionType"`
+	Types            []*introspectionType      `json:"types"`
+	Directives       []*introspectionDirective `json:"directives"`
+}
+
+type introspectionNamed struct {
+	Name string `json:"name"`
+}
+
+type introspectionType struct {
+	Kind          string                    `json:"kind"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description"`
+	Fields        []*introspectionField     `json:"fields"`
+	InputFields   []*introspectionInput     `json:"inputFields"`
+	Interfaces    []*introspectionNamed     `json:"interfaces"`
+	EnumValues    []*introspectionEnumValue `json:"enumValues"`
+	PossibleTypes []*introspectionNamed     `json:"possibleTypes"`
+	IsOneOf       bool                      `json:"isOneOf"`
+}
+
+type introspectionField struct {
+	Name              string                `json:"name"`
+	Description       string                `json:"description"`
+	Args              []*introspectionInput `json:"args"`
+	Type              *introspectionTypeRef `json:"type"`
+	IsDeprecated      bool                  `json:"isDeprecated"`
+	DeprecationReason *string               `json:"deprecationReason"`
+}
+
+type introspectionInput struct {
+	Name              string                `json:"name"`
+	Description       string                `json:"description"`
+	Type              *introspectionTypeRef `json:"type"`
+	DefaultValue      *string               `json:"defaultValue"`
+	IsDeprecated      bool                  `json:"isDeprecated"`
+	DeprecationReason *string               `json:"deprecationReason"`
+}
+
+type introspectionEnumValue struct {
+	Name              string  `json:"name"`
+	Description       string  `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+type introspectionDirective struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Locations   []string              `json:"locations"`
+	Args        []*introspectionInput `json:"args"`
+}
+
+// IsIntrospectionJSON reports whether the input looks like a JSON
+// introspection result rather than SDL. SDL documents never start with '{'.
+func IsIntrospectionJSON(data []byte) bool {
+	trimmed := bytes.TrimSpace(data)
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+// ParseIntrospection parses a GraphQL introspection query result.
+func (p *Parser) ParseIntrospection(data []byte, sourceName string) (*Schema, error) {
+	var result introspectionResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, errors.NewParseError("failed to parse introspection JSON", err).
+			WithLocation(&errors.Location{File: sourceName})
+	}
+
+	schemaData := result.Schema
+	if result.Data != nil && result.Data.Schema != nil {
+		schemaData = result.Data.Schema
+	}
+	if schemaData == nil {
+		message := "introspection result has no __schema"
+		if len(result.Errors) > 0 {
+			message = fmt.Sprintf("%s (server error: %s)", message, result.Errors[0].Message)
+		}
+		return nil, errors.NewParseError(message, nil).
+			WithLocation(&errors.Location{File: sourceName})
+	}
+
+	return p.convertIntrospection(schemaData, sourceName)
+}
+
+func (p *Parser) convertIntrospection(data *introspectionSchema, sourceName string) (*Schema, error) {
+	schema := &Schema{
+		Types:      make(map[string]*TypeDef),
+		Directives: make(map[string]*DirectiveDefinition),
+	}
+
+	if data.QueryType != nil {
+		schema.QueryType = data.QueryType.Name
+	}
+	if data.MutationType != nil {
+		schema.MutationType = data.MutationType.Name
+	}
+	if data.SubscriptionType != nil {
+		schema.SubscriptionType = data.SubscriptionType.Name
+	}
+
+	location := &errors.Location{File: sourceName}
+
+	for _, t := range data.Types {
+		if t == nil || isBuiltinType(t.Name) {
+			continue
+		}
+
+		kind, ok := convertIntrospectionKind(t.Kind)
+		if !ok {
+			continue
+		}
+
+		typeDef := &TypeDef{
+			Name:        t.Name,
+			Kind:        kind,
+			Description: t.Description,
+			Location:    location,
+		}
+		if t.IsOneOf {
+			typeDef.Directives = []*DirectiveDef{{Name: DirectiveOneOf}}
+		}
+
+		for _, iface := range t.Interfaces {
+			typeDef.Interfaces = append(typeDef.Interfaces, iface.Name)
+		}
+		if kind == TypeKindUnion {
+			for _, member := range t.PossibleTypes {
+				typeDef.PossibleTypes = append(typeDef.PossibleTypes, member.Name)
+			}
+		}
+
+		for _, f := range t.Fields {
+			fieldDef := &FieldDef{
+				Name:        f.Name,
+				Description: f.Description,
+				Type:        convertIntrospectionTypeRef(f.Type),
+				Directives:  deprecationDirectives(f.IsDeprecated, f.DeprecationReason),
+				Location:    location,
+			}
+			for _, arg := range f.Args {
+				argDef, err := convertIntrospectionInput(arg, t.Name)
+				if err != nil {
+					return nil, err
+				}
+				fieldDef.Arguments = append(fieldDef.Arguments, argDef)
+			}
+			typeDef.Fields = append(typeDef.Fields, fieldDef)
+		}
+
+		for _, f := range t.InputFields {
+			argDef, err := convertIntrospectionInput(f, t.Name)
+			if err != nil {
+				return nil, err
+			}
+			typeDef.Fields = append(typeDef.Fields, &FieldDef{
+				Name:         f.Name,
+				Description:  f.Description,
+				Type:         argDef.Type,
+				DefaultValue: argDef.DefaultValue,
+				Directives:   deprecationDirectives(f.IsDeprecated, f.DeprecationReason),
+				Location:     location,
+			})
+		}
+
+		for _, ev := range t.EnumValues {
+			typeDef.EnumValues = append(typeDef.EnumValues, &EnumValueDef{
+				Name:        ev.Name,
+				Description: ev.Description,
+				Directives:  deprecationDirectives(ev.IsDeprecated, ev.DeprecationReason),
+				Location:    location,
+			})
+		}
+
+		schema.Types[t.Name] = typeDef
+	}
+
+	for _, d := range data.Directives {
+		directive := &DirectiveDefinition{
+			Name:        d.Name,
+			Description: d.Description,
+			Locations:   d.Locations,
+		}
+		for _, arg := range d.Args {
+			argDef, err := convertIntrospectionInput(arg, "@"+d.Name)
+			if err != nil {
+				return nil, err
+			}
+			directive.Arguments = append(directive.Arguments, argDef)
+		}
+		schema.Directives[d.Name] = directive
+	}
+
+	return schema, nil
+}
+
+func convertIntrospectionInput(input *introspectionInput, owner string) (*ArgumentDef, error) {
+	argDef := &ArgumentDef{
+		Name:        input.Name,
+		Description: input.Description,
+		Type:        convertIntrospectionTypeRef(input.Type),
+	}
+
+	if input.DefaultValue != nil {
+		value, err := parseValueLiteral(*input.DefaultValue)
+		if err != nil {
+			return nil, errors.NewParseError(
+				fmt.Sprintf("invalid default value for %s.%s: %s", owner, input.Name, *input.DefaultValue),
+				err,
+			).WithTypeName(owner)
+		}
+		argDef.DefaultValue = value
+	}
+
+	return argDef, nil
+}
+
+// parseValueLiteral parses a GraphQL value literal as printed in the
+// defaultValue field of an introspection result.
+func parseValueLiteral(literal string) (interface{}, error) {
+	doc, err := gqlparser.ParseQuery(&ast.Source{
+		Name:  "defaultValue",
+		Input: "{ f(v: " + literal + ") }",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	field, ok := doc.Operations[0].SelectionSet[0].(*ast.Field)
+	if !ok || len(field.Arguments) != 1 {
+		return nil, fmt.Errorf("unexpected value literal: %s", literal)
+	}
+
+	return valueToInterface(field.Arguments[0].Value), nil
+}
+
+func convertIntrospectionTypeRef(ref *introspectionTypeRef) *TypeRef {
+	if ref == nil {
+		return nil
+	}
+
+	switch ref.Kind {
+	case "NON_NULL":
+		inner := convertIntrospectionTypeRef(ref.OfType)
+		if inner != nil {
+			inner.NonNull = true
+		}
+		return inner
+	case "LIST":
+		return &TypeRef{Elem: convertIntrospectionTypeRef(ref.OfType)}
+	default:
+		return &TypeRef{Name: ref.Name}
+	}
+}
+
+func convertIntrospectionKind(kind string) (TypeKind, bool) {
+	switch TypeKind(kind) {
+	case TypeKindObject, TypeKindInterface, TypeKindInputObject,
+		TypeKindEnum, TypeKindUnion, TypeKindScalar:
+		return TypeKind(kind), true
+	default:
+		return "", false
+	}
+}
+
+// deprecationDirectives recreates the @deprecated directive that introspection
+// reports as isDeprecated/deprecationReason.
+func deprecationDirectives(deprecated bool, reason *string) []*DirectiveDef {
+	if !deprecated {
+		return nil
+	}
+
+	directive := &DirectiveDef{Name: DirectiveDeprecated}
+	if reason != nil {
+		directive.Arguments = map[string]interface{}{"reason": *reason}
+	}
+	return []*DirectiveDef{directive}
+}
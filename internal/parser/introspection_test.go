@@ -0,0 +1,152 @@
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_ParseFile_Introspection(t *testing.T) {
+	p := NewParser()
+	schema, err := p.ParseFile(filepath.Join("..", "..", "testdata", "schemas", "introspection.json"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Query", schema.QueryType)
+	assert.Empty(t, schema.MutationType)
+	assert.Nil(t, schema.GetType("String"), "built-in scalars are skipped")
+	assert.Nil(t, schema.GetType("__Schema"), "introspection types are skipped")
+
+	user := schema.GetType("User")
+	require.NotNil(t, user)
+	assert.Equal(t, TypeKindObject, user.Kind)
+	assert.Equal(t, "A registered user", user.Description)
+	assert.Equal(t, []string{"Node"}, user.Interfaces)
+
+	id := findField(user.Fields, "id")
+	require.NotNil(t, id)
+	assert.Equal(t, &TypeRef{Name: "ID", NonNull: true}, id.Type)
+
+	login := findField(user.Fields, "login")
+	require.NotNil(t, login)
+	deprecated := ExtractDeprecatedDirective(login.Directives)
+	require.NotNil(t, deprecated)
+	assert.Equal(t, "Use email instead", deprecated.Reason)
+
+	assert.Equal(t, TypeKindScalar, schema.GetType("DateTime").Kind)
+}
+
+func TestParser_ParseIntrospection_TypeRefsAndArguments(t *testing.T) {
+	p := NewParser()
+	schema, err := p.ParseFile(filepath.Join("..", "..", "testdata", "schemas", "introspection.json"))
+	require.NoError(t, err)
+
+	users := findField(schema.GetType("Query").Fields, "users")
+	require.NotNil(t, users)
+
+	// [User!]!
+	require.True(t, users.Type.IsList())
+	assert.True(t, users.Type.NonNull)
+	assert.True(t, users.Type.Elem.NonNull)
+	assert.Equal(t, "User", users.Type.NamedType())
+
+	require.Len(t, users.Arguments, 2)
+	assert.Equal(t, "first", users.Arguments[0].Name)
+	assert.Equal(t, "Page size", users.Arguments[0].Description)
+	assert.Equal(t, int64(10), users.Arguments[0].DefaultValue)
+	assert.Equal(t, "USER", users.Arguments[1].DefaultValue)
+}
+
+func TestParser_ParseIntrospection_UnionsEnumsAndInputs(t *testing.T) {
+	p := NewParser()
+	schema, err := p.ParseFile(filepath.Join("..", "..", "testdata", "schemas", "introspection.json"))
+	require.NoError(t, err)
+
+	union := schema.GetType("SearchResult")
+	require.NotNil(t, union)
+	assert.Equal(t, []string{"User", "Post"}, union.PossibleTypes)
+
+	role := schema.GetType("Role")
+	require.NotNil(t, role)
+	require.Len(t, role.EnumValues, 3)
+	assert.Equal(t, "Full access", role.EnumValues[0].Description)
+	assert.NotNil(t, ExtractDeprecatedDirective(role.EnumValues[2].Directives))
+
+	filter := schema.GetType("UserFilter")
+	require.NotNil(t, filter)
+	require.Len(t, filter.Fields, 2)
+	assert.Equal(t, []interface{}{"ADMIN", "USER"}, filter.Fields[0].DefaultValue)
+	assert.Equal(t, "*", filter.Fields[1].DefaultValue)
+	assert.Equal(t, "Free text search", filter.Fields[1].Description)
+
+	deprecated := schema.Directives["deprecated"]
+	require.NotNil(t, deprecated)
+	assert.Equal(t, []string{"FIELD_DEFINITION", "ENUM_VALUE"}, deprecated.Locations)
+	assert.Equal(t, "No longer supported", deprecated.Arguments[0].DefaultValue)
+}
+
+func TestParser_ParseIntrospection_BareSchema(t *testing.T) {
+	input := `{"__schema": {"queryType": {"name": "Query"}, "types": [
+		{"kind": "OBJECT", "name": "Query", "fields": [
+			{"name": "ping", "args": [], "type": {"kind": "SCALAR", "name": "String"}}
+		]}
+	]}}`
+
+	p := NewParser()
+	schema, err := p.ParseReader(strings.NewReader(input), "<stdin>")
+	require.NoError(t, err)
+
+	query := schema.GetType("Query")
+	require.NotNil(t, query)
+	assert.Equal(t, "ping", query.Fields[0].Name)
+	assert.Equal(t, "<stdin>", query.Location.File)
+}
+
+func TestParser_ParseIntrospection_MissingSchema(t *testing.T) {
+	p := NewParser()
+	_, err := p.Parse(`{"data": null, "errors": [{"message": "introspection disabled"}]}`, "dump.json")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no __schema")
+	assert.Contains(t, err.Error(), "introspection disabled")
+}
+
+func TestParser_ParseIntrospection_InvalidJSON(t *testing.T) {
+	p := NewParser()
+	_, err := p.Parse(`{"__schema": `, "dump.json")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse introspection JSON")
+}
+
+func TestParser_ParseFiles_RejectsMixedIntrospection(t *testing.T) {
+	p := NewParser()
+	_, err := p.ParseFiles([]string{
+		filepath.Join("..", "..", "testdata", "schemas", "introspection.json"),
+		filepath.Join("..", "..", "testdata", "schemas", "basic.graphql"),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be combined")
+}
+
+func TestIsIntrospectionJSON(t *testing.T) {
+	assert.True(t, IsIntrospectionJSON([]byte("  \n{\"data\": {}}")))
+	assert.False(t, IsIntrospectionJSON([]byte("type Query { a: String }")))
+	assert.False(t, IsIntrospectionJSON([]byte("")))
+}
+
+func TestParser_ParseIntrospection_OneOf(t *testing.T) {
+	input := `{"__schema": {"queryType": {"name": "Query"}, "types": [
+		{"kind": "INPUT_OBJECT", "name": "PaymentInput", "isOneOf": true, "inputFields": [
+			{"name": "iban", "type": {"kind": "SCALAR", "name": "String"}}
+		]}
+	]}}`
+
+	p := NewParser()
+	schema, err := p.Parse(input, "dump.json")
+	require.NoError(t, err)
+
+	payment := schema.GetType("PaymentInput")
+	require.NotNil(t, payment)
+	assert.True(t, payment.IsOneOf())
+}
@@ -0,0 +1,28 @@
+package parser
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// oneOfSourceName is the source name of the injected @oneOf definition.
+const oneOfSourceName = "<oneOf>"
+
+// oneOfDefinition is the definition of the @oneOf directive of the GraphQL
+// specification.
+const oneOfDefinition = `directive @oneOf on INPUT_OBJECT`
+
+// oneOfSource returns a source with the definition of @oneOf if the schema
+// uses the directive without declaring it, or nil.
+func oneOfSource(doc *ast.SchemaDocument) *ast.Source {
+	for _, d := range doc.Directives {
+		if d.Name == DirectiveOneOf {
+			return nil
+		}
+	}
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		if def.Directives.ForName(DirectiveOneOf) != nil {
+			return &ast.Source{Name: oneOfSourceName, Input: oneOfDefinition}
+		}
+	}
+	return nil
+}
@@ -2,12 +2,14 @@ package parser
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/source-c/go-gql2j/internal/errors"
 )
@@ -31,6 +33,18 @@ func (p *Parser) ParseFile(path string) (*Schema, error) {
 	return p.Parse(string(data), path)
 }
 
+// ParseReader parses a GraphQL schema (SDL or introspection JSON) from a reader,
+// e.g. standard input.
+func (p *Parser) ParseReader(r io.Reader, sourceName string) (*Schema, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.NewParseError("failed to read schema", err).
+			WithLocation(&errors.Location{File: sourceName})
+	}
+
+	return p.Parse(string(data), sourceName)
+}
+
 // ParseFiles parses multiple GraphQL schema files.
 func (p *Parser) ParseFiles(paths []string) (*Schema, error) {
 	var sources []*ast.Source
@@ -40,6 +54,14 @@ func (p *Parser) ParseFiles(paths []string) (*Schema, error) {
 			return nil, errors.NewParseError("failed to read schema file", err).
 				WithLocation(&errors.Location{File: path})
 		}
+		if IsIntrospectionJSON(data) {
+			if len(paths) > 1 {
+				return nil, errors.NewParseError(
+					"introspection results cannot be combined with other schema files", nil,
+				).WithLocation(&errors.Location{File: path})
+			}
+			return p.ParseIntrospection(data, path)
+		}
 		sources = append(sources, &ast.Source{
 			Name:  path,
 			Input: string(data),
@@ -51,8 +73,60 @@ func (p *Parser) ParseFiles(paths []string) (*Schema, error) {
 
 // ParseWithIncludes parses a main schema file and additional include patterns.
 func (p *Parser) ParseWithIncludes(mainPath string, includePatterns []string) (*Schema, error) {
-	paths := []string{mainPath}
+	paths, err := includePaths(mainPath, includePatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ParseFiles(append([]string{mainPath}, paths...))
+}
+
+// ParseReaderWithIncludes parses a main schema read from a reader, e.g.
+// standard input, and the files matching the include patterns.
+func (p *Parser) ParseReaderWithIncludes(r io.Reader, sourceName string, includePatterns []string) (*Schema, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.NewParseError("failed to read schema", err).
+			WithLocation(&errors.Location{File: sourceName})
+	}
+	paths, err := includePaths("", includePatterns)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return p.Parse(string(data), sourceName)
+	}
+
+	sources := []*ast.Source{{Name: sourceName, Input: string(data)}}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.NewParseError("failed to read schema file", err).
+				WithLocation(&errors.Location{File: path})
+		}
+		sources = append(sources, &ast.Source{Name: path, Input: string(data)})
+	}
+	for _, source := range sources {
+		if IsIntrospectionJSON([]byte(source.Input)) {
+			return nil, errors.NewParseError(
+				"introspection results cannot be combined with other schema files", nil,
+			).WithLocation(&errors.Location{File: source.Name})
+		}
+	}
 
+	return p.parseFromSources(sources)
+}
+
+// includePaths returns the files matching the include patterns, without
+// duplicates and without the main schema file, if any.
+func includePaths(mainPath string, includePatterns []string) ([]string, error) {
+	seen := make(map[string]bool)
+	if mainPath != "" {
+		absPath, _ := filepath.Abs(mainPath)
+		seen[absPath] = true
+	}
+
+	var paths []string
 	for _, pattern := range includePatterns {
 		matches, err := filepath.Glob(pattern)
 		if err != nil {
@@ -61,25 +135,24 @@ func (p *Parser) ParseWithIncludes(mainPath string, includePatterns []string) (*
 				err,
 			)
 		}
-		paths = append(paths, matches...)
-	}
-
-	// Remove duplicates
-	seen := make(map[string]bool)
-	var uniquePaths []string
-	for _, path := range paths {
-		absPath, _ := filepath.Abs(path)
-		if !seen[absPath] {
-			seen[absPath] = true
-			uniquePaths = append(uniquePaths, path)
+		for _, path := range matches {
+			absPath, _ := filepath.Abs(path)
+			if !seen[absPath] {
+				seen[absPath] = true
+				paths = append(paths, path)
+			}
 		}
 	}
-
-	return p.ParseFiles(uniquePaths)
+	return paths, nil
 }
 
-// Parse parses a GraphQL schema from a string.
+// Parse parses a GraphQL schema from a string. Input that is an introspection
+// query result in JSON form is detected and parsed with ParseIntrospection.
 func (p *Parser) Parse(input string, sourceName string) (*Schema, error) {
+	if IsIntrospectionJSON([]byte(input)) {
+		return p.ParseIntrospection([]byte(input), sourceName)
+	}
+
 	source := &ast.Source{
 		Name:  sourceName,
 		Input: input,
@@ -89,6 +162,8 @@ func (p *Parser) Parse(input string, sourceName string) (*Schema, error) {
 }
 
 func (p *Parser) parseFromSources(sources []*ast.Source) (*Schema, error) {
+	sources, doc := injectDefinitions(sources)
+
 	astSchema, err := gqlparser.LoadSchema(sources...)
 	if err != nil {
 		// Try with schema definition if not present
@@ -99,16 +174,44 @@ func (p *Parser) parseFromSources(sources []*ast.Source) (*Schema, error) {
 				Name:  sources[0].Name,
 				Input: "schema { query: Query }\n" + sources[0].Input,
 			}
-			astSchema, err = gqlparser.LoadSchema(augmentedSources...)
+			var retryErr error
+			if astSchema, retryErr = gqlparser.LoadSchema(augmentedSources...); retryErr == nil {
+				err = nil
+			}
 		}
 		if err != nil {
-			return nil, errors.NewParseError("failed to parse GraphQL schema", err)
+			// Report the error of the schema as written; the retry's error
+			// is usually about the added schema definition
+			return nil, schemaError(err, doc)
 		}
 	}
 
 	return p.convertSchema(astSchema)
 }
 
+// schemaError converts a gqlparser error into a ParseError carrying its
+// location and a hint.
+func schemaError(err error, doc *ast.SchemaDocument) error {
+	gqlErr, ok := err.(*gqlerror.Error)
+	if !ok {
+		return errors.NewParseError("failed to parse GraphQL schema", err)
+	}
+
+	parseErr := errors.NewParseError(gqlErr.Message, nil)
+	parseErr.WithHint(schemaHint(gqlErr.Message, doc))
+	file, _ := gqlErr.Extensions["file"].(string)
+	if file == "" && len(gqlErr.Locations) == 0 {
+		return parseErr
+	}
+
+	loc := &errors.Location{File: file}
+	if len(gqlErr.Locations) > 0 {
+		loc.Line = gqlErr.Locations[0].Line
+		loc.Column = gqlErr.Locations[0].Column
+	}
+	return parseErr.WithLocation(loc)
+}
+
 func hasSchemaDefinition(sources []*ast.Source) bool {
 	for _, s := range sources {
 		if strings.Contains(s.Input, "schema {") || strings.Contains(s.Input, "schema{") {
@@ -144,6 +247,17 @@ func (p *Parser) convertSchema(astSchema *ast.Schema) (*Schema, error) {
 		schema.Directives[name] = p.convertDirectiveDefinition(def)
 	}
 
+	// Record root operation types
+	if astSchema.Query != nil {
+		schema.QueryType = astSchema.Query.Name
+	}
+	if astSchema.Mutation != nil {
+		schema.MutationType = astSchema.Mutation.Name
+	}
+	if astSchema.Subscription != nil {
+		schema.SubscriptionType = astSchema.Subscription.Name
+	}
+
 	return schema, nil
 }
 
@@ -153,24 +267,15 @@ func (p *Parser) convertTypeDef(def *ast.Definition) (*TypeDef, error) {
 		return nil, nil // Skip unsupported types
 	}
 
-	sourceName := ""
-	if def.Position != nil {
-		sourceName = def.Position.Src.Name
-	}
-
+	// Extensions (extend type/enum/input/union) are already merged into the
+	// definition by gqlparser. Every field, value and directive keeps its own
+	// position, so locations point at the file that declared them.
 	typeDef := &TypeDef{
 		Name:        def.Name,
 		Kind:        kind,
 		Description: def.Description,
-		Directives:  extractDirectives(def.Directives, sourceName),
-	}
-
-	if def.Position != nil {
-		typeDef.Location = &errors.Location{
-			File:   sourceName,
-			Line:   def.Position.Line,
-			Column: def.Position.Column,
-		}
+		Directives:  extractDirectives(def.Directives),
+		Location:    convertPosition(def.Position),
 	}
 
 	// Convert interfaces
@@ -178,35 +283,33 @@ func (p *Parser) convertTypeDef(def *ast.Definition) (*TypeDef, error) {
 		typeDef.Interfaces = append(typeDef.Interfaces, iface)
 	}
 
+	// Convert union members
+	for _, member := range def.Types {
+		typeDef.PossibleTypes = append(typeDef.PossibleTypes, member)
+	}
+
 	// Convert fields
 	for _, field := range def.Fields {
-		fieldDef := p.convertFieldDef(field, sourceName)
+		fieldDef := p.convertFieldDef(field)
 		typeDef.Fields = append(typeDef.Fields, fieldDef)
 	}
 
 	// Convert enum values
 	for _, ev := range def.EnumValues {
-		enumValue := p.convertEnumValue(ev, sourceName)
+		enumValue := p.convertEnumValue(ev)
 		typeDef.EnumValues = append(typeDef.EnumValues, enumValue)
 	}
 
 	return typeDef, nil
 }
 
-func (p *Parser) convertFieldDef(field *ast.FieldDefinition, sourceName string) *FieldDef {
+func (p *Parser) convertFieldDef(field *ast.FieldDefinition) *FieldDef {
 	fieldDef := &FieldDef{
 		Name:        field.Name,
 		Description: field.Description,
 		Type:        convertTypeRef(field.Type),
-		Directives:  extractDirectives(field.Directives, sourceName),
-	}
-
-	if field.Position != nil {
-		fieldDef.Location = &errors.Location{
-			File:   sourceName,
-			Line:   field.Position.Line,
-			Column: field.Position.Column,
-		}
+		Directives:  extractDirectives(field.Directives),
+		Location:    convertPosition(field.Position),
 	}
 
 	// Convert arguments
@@ -215,6 +318,7 @@ func (p *Parser) convertFieldDef(field *ast.FieldDefinition, sourceName string)
 			Name:        arg.Name,
 			Description: arg.Description,
 			Type:        convertTypeRef(arg.Type),
+			Location:    convertPosition(arg.Position),
 		}
 		if arg.DefaultValue != nil {
 			argDef.DefaultValue = valueToInterface(arg.DefaultValue)
@@ -229,22 +333,30 @@ func (p *Parser) convertFieldDef(field *ast.FieldDefinition, sourceName string)
 	return fieldDef
 }
 
-func (p *Parser) convertEnumValue(ev *ast.EnumValueDefinition, sourceName string) *EnumValueDef {
-	enumValue := &EnumValueDef{
+func (p *Parser) convertEnumValue(ev *ast.EnumValueDefinition) *EnumValueDef {
+	return &EnumValueDef{
 		Name:        ev.Name,
 		Description: ev.Description,
-		Directives:  extractDirectives(ev.Directives, sourceName),
+		Directives:  extractDirectives(ev.Directives),
+		Location:    convertPosition(ev.Position),
 	}
+}
 
-	if ev.Position != nil {
-		enumValue.Location = &errors.Location{
-			File:   sourceName,
-			Line:   ev.Position.Line,
-			Column: ev.Position.Column,
-		}
+// convertPosition converts an AST position to a Location in the file it
+// was read from.
+func convertPosition(pos *ast.Position) *errors.Location {
+	if pos == nil {
+		return nil
 	}
 
-	return enumValue
+	loc := &errors.Location{
+		Line:   pos.Line,
+		Column: pos.Column,
+	}
+	if pos.Src != nil {
+		loc.File = pos.Src.Name
+	}
+	return loc
 }
 
 func (p *Parser) convertDirectiveDefinition(def *ast.DirectiveDefinition) *DirectiveDefinition {
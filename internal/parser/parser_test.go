@@ -3,10 +3,14 @@ package parser
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/source-c/go-gql2j/internal/errors"
 )
 
 func TestParser_Parse_BasicSchema(t *testing.T) {
@@ -267,6 +271,23 @@ func TestParser_ParseWithIncludes(t *testing.T) {
 	assert.NotNil(t, result.GetType("Query"))
 }
 
+func TestParser_ParseReaderWithIncludes(t *testing.T) {
+	tmpDir := t.TempDir()
+	userSchema := filepath.Join(tmpDir, "user.graphql")
+	require.NoError(t, os.WriteFile(userSchema, []byte(`type User { id: ID! name: String! }`), 0644))
+
+	p := NewParser()
+	result, err := p.ParseReaderWithIncludes(strings.NewReader(`type Query { users: [User] }`), "<stdin>", []string{filepath.Join(tmpDir, "*.graphql")})
+	require.NoError(t, err)
+	assert.NotNil(t, result.GetType("User"))
+	assert.NotNil(t, result.GetType("Query"))
+
+	// Introspection results cannot be merged with includes
+	_, err = p.ParseReaderWithIncludes(strings.NewReader(`{"data": {"__schema": {"types": []}}}`), "<stdin>", []string{userSchema})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "introspection results cannot be combined with other schema files")
+}
+
 func TestSchema_TypesByKind(t *testing.T) {
 	schema := `
 type User { id: ID! }
@@ -309,6 +330,12 @@ func TestTypeRef_NamedType(t *testing.T) {
 	assert.True(t, list.IsList())
 }
 
+func TestTypeRef_String(t *testing.T) {
+	assert.Equal(t, "String!", (&TypeRef{Name: "String", NonNull: true}).String())
+	assert.Equal(t, "[String!]!", (&TypeRef{Elem: &TypeRef{Name: "String", NonNull: true}, NonNull: true}).String())
+	assert.Equal(t, "[[Int]]", (&TypeRef{Elem: &TypeRef{Elem: &TypeRef{Name: "Int"}}}).String())
+}
+
 func TestDirectiveDef_GetArguments(t *testing.T) {
 	directive := &DirectiveDef{
 		Name: "constraint",
@@ -355,6 +382,28 @@ func TestDirectiveDef_GetArgumentStringSlice(t *testing.T) {
 	assert.Nil(t, directive.GetArgumentStringSlice("nonexistent"))
 }
 
+func TestDirectiveDef_IgnoredArguments(t *testing.T) {
+	directive := &DirectiveDef{
+		Name: DirectiveConstraint,
+		Arguments: map[string]interface{}{
+			"minLength": int64(1),
+			"maxLength": "10",
+			"pattern":   "^[a-z]+$",
+			"unknown":   true,
+		},
+	}
+	assert.Equal(t, []string{"maxLength", "unknown"}, directive.IgnoredArguments())
+
+	valid := &DirectiveDef{
+		Name:      DirectiveJavaType,
+		Arguments: map[string]interface{}{"type": "Money", "imports": []interface{}{"com.example.Money"}},
+	}
+	assert.Empty(t, valid.IgnoredArguments())
+
+	other := &DirectiveDef{Name: "key", Arguments: map[string]interface{}{"fields": "id"}}
+	assert.Nil(t, other.IgnoredArguments())
+}
+
 // Helper function
 func findField(fields []*FieldDef, name string) *FieldDef {
 	for _, f := range fields {
@@ -364,3 +413,339 @@ func findField(fields []*FieldDef, name string) *FieldDef {
 	}
 	return nil
 }
+
+func TestTypeDef_SameAs_IgnoresLocations(t *testing.T) {
+	p := NewParser()
+	first, err := p.Parse("type User { id: ID! name: String }", "a.graphql")
+	require.NoError(t, err)
+	second, err := p.Parse("\n\n# moved\ntype User {\n  id: ID!\n  name: String\n}", "b.graphql")
+	require.NoError(t, err)
+
+	assert.True(t, first.GetType("User").SameAs(second.GetType("User")))
+}
+
+func TestTypeDef_SameAs_DetectsChanges(t *testing.T) {
+	p := NewParser()
+	first, err := p.Parse("type User { id: ID! name: String }", "a.graphql")
+	require.NoError(t, err)
+	second, err := p.Parse("type User { id: ID! name: String! }", "a.graphql")
+	require.NoError(t, err)
+
+	assert.False(t, first.GetType("User").SameAs(second.GetType("User")))
+	assert.False(t, first.GetType("User").SameAs(nil))
+}
+
+func TestTypeDef_ReferencedTypes(t *testing.T) {
+	p := NewParser()
+	result, err := p.Parse(`
+interface Node { id: ID! }
+type User implements Node {
+  id: ID!
+  posts(filter: PostFilter): [Post!]!
+}
+type Post { title: String }
+input PostFilter { title: String }
+`, "test.graphql")
+	require.NoError(t, err)
+
+	refs := result.GetType("User").ReferencedTypes()
+	assert.ElementsMatch(t, []string{"Node", "ID", "Post", "PostFilter"}, refs)
+}
+
+func TestParser_Parse_UnionMembersAndRootTypes(t *testing.T) {
+	schema := `
+type Query { search: [SearchResult] }
+type Mutation { noop: Boolean }
+type User { id: ID! }
+type Post { id: ID! }
+union SearchResult = User | Post
+`
+	p := NewParser()
+	result, err := p.Parse(schema, "test.graphql")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"User", "Post"}, result.GetType("SearchResult").PossibleTypes)
+	assert.Equal(t, "Query", result.QueryType)
+	assert.Equal(t, "Mutation", result.MutationType)
+	assert.Empty(t, result.SubscriptionType)
+	assert.True(t, result.IsRootType("Mutation"))
+	assert.False(t, result.IsRootType("User"))
+}
+
+func extensionsPath(name string) string {
+	return filepath.Join("..", "..", "testdata", "schemas", "extensions", name)
+}
+
+func TestParser_ParseWithIncludes_MergesTypeExtensions(t *testing.T) {
+	p := NewParser()
+	result, err := p.ParseWithIncludes(extensionsPath("base.graphql"), []string{
+		extensionsPath("users.graphql"),
+		extensionsPath("posts.graphql"),
+	})
+	require.NoError(t, err)
+
+	query := result.GetType("Query")
+	require.NotNil(t, query)
+	assert.Len(t, query.Fields, 3+2, "base field, two extension fields and the introspection fields")
+	assert.Equal(t, extensionsPath("base.graphql"), query.Location.File)
+
+	node := findField(query.Fields, "node")
+	require.NotNil(t, node)
+	assert.Equal(t, extensionsPath("base.graphql"), node.Location.File)
+
+	users := findField(query.Fields, "users")
+	require.NotNil(t, users)
+	assert.Equal(t, extensionsPath("users.graphql"), users.Location.File)
+	assert.Equal(t, 2, users.Location.Line)
+	require.Len(t, users.Arguments, 1)
+	assert.Equal(t, extensionsPath("users.graphql"), users.Arguments[0].Location.File)
+
+	posts := findField(query.Fields, "posts")
+	require.NotNil(t, posts)
+	assert.Equal(t, extensionsPath("posts.graphql"), posts.Location.File)
+}
+
+func TestParser_ParseWithIncludes_ExtensionDirectivesAndKinds(t *testing.T) {
+	p := NewParser()
+	result, err := p.ParseWithIncludes(extensionsPath("base.graphql"), []string{
+		extensionsPath("users.graphql"),
+		extensionsPath("posts.graphql"),
+	})
+	require.NoError(t, err)
+
+	// Directives from the extension are merged into the base type
+	user := result.GetType("User")
+	require.NotNil(t, user)
+	javaName := user.GetDirective(DirectiveJavaName)
+	require.NotNil(t, javaName)
+	assert.Equal(t, extensionsPath("users.graphql"), javaName.Location.File)
+	role := findField(user.Fields, "role")
+	require.NotNil(t, role)
+	assert.Equal(t, extensionsPath("users.graphql"), role.Location.File)
+
+	// extend enum
+	roleEnum := result.GetType("Role")
+	require.Len(t, roleEnum.EnumValues, 3)
+	assert.Equal(t, "GUEST", roleEnum.EnumValues[2].Name)
+	assert.Equal(t, extensionsPath("users.graphql"), roleEnum.EnumValues[2].Location.File)
+	assert.Equal(t, extensionsPath("base.graphql"), roleEnum.EnumValues[0].Location.File)
+
+	// extend input
+	filter := result.GetType("UserFilter")
+	require.Len(t, filter.Fields, 2)
+	assert.Equal(t, extensionsPath("users.graphql"), filter.Fields[1].Location.File)
+
+	// extend union, with the member type defined in the extending file
+	search := result.GetType("SearchResult")
+	assert.Equal(t, []string{"User", "Post"}, search.PossibleTypes)
+	assert.Equal(t, TypeKindObject, result.GetType("Post").Kind)
+}
+
+func TestParser_ParseFiles_ExtensionBeforeBaseType(t *testing.T) {
+	p := NewParser()
+	result, err := p.ParseFiles([]string{
+		extensionsPath("early.graphql"),
+		extensionsPath("base.graphql"),
+		extensionsPath("comment.graphql"),
+	})
+	require.NoError(t, err)
+
+	comment := result.GetType("Comment")
+	require.NotNil(t, comment)
+	assert.Equal(t, extensionsPath("comment.graphql"), comment.Location.File)
+	require.Len(t, comment.Fields, 3)
+	assert.Equal(t, "id", comment.Fields[0].Name)
+	assert.Equal(t, "author", comment.Fields[2].Name)
+	assert.Equal(t, extensionsPath("early.graphql"), comment.Fields[2].Location.File)
+}
+
+func TestParser_Parse_ExtensionKindMismatch(t *testing.T) {
+	p := NewParser()
+	_, err := p.Parse("type Role { id: ID }\nextend enum Role { GUEST }", "test.graphql")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Cannot extend type Role")
+}
+
+func TestParser_Parse_ErrorLocation(t *testing.T) {
+	p := NewParser()
+
+	// No schema definition, so the parser retries with one prepended
+	_, err := p.Parse("type Query { user: User }\ntype User {\n  posts: [Post]\n}", "test.graphql")
+	require.Error(t, err)
+
+	var genErr *errors.GeneratorError
+	require.ErrorAs(t, err, &genErr)
+	assert.Equal(t, errors.CodeParse, genErr.Code)
+	assert.Equal(t, "Undefined type Post.", genErr.Message)
+	assert.Equal(t, &errors.Location{File: "test.graphql", Line: 3, Column: 11}, genErr.Location)
+}
+
+func TestParser_Parse_ErrorWithoutQuery(t *testing.T) {
+	// The retry with a schema definition fails as well, since there is no
+	// Query type; the error of the schema as written is reported
+	_, err := NewParser().Parse("type User @javaName(name: \"Account\") {\n  id: ID\n}", "test.graphql")
+	require.Error(t, err)
+
+	var genErr *errors.GeneratorError
+	require.ErrorAs(t, err, &genErr)
+	assert.Equal(t, "Undefined directive javaName.", genErr.Message)
+	assert.Equal(t, 1, genErr.Location.Line)
+}
+
+func TestParser_Parse_ErrorHints(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		hint   string
+	}{
+		{
+			name:   "misspelled type",
+			schema: "type Query { user: Usr }\ntype User { id: ID }",
+			hint:   "did you mean User?",
+		},
+		{
+			name:   "misspelled built-in scalar",
+			schema: "type Query { name: Strng }",
+			hint:   "did you mean String?",
+		},
+		{
+			name:   "misspelled gql2j directive",
+			schema: "directive @javaName(name: String!) on OBJECT\ntype Query @javaNme(name: \"Root\") { id: ID }",
+			hint:   "did you mean @javaName?",
+		},
+		{
+			name:   "undeclared gql2j directive",
+			schema: "type Query @javaNme(name: \"Root\") { id: ID }",
+			hint:   "did you mean @javaName?",
+		},
+		{
+			name:   "gql2j directive not declared",
+			schema: "type Query @javaName(name: \"Root\") { id: ID }",
+			hint:   "declare the gql2j directives in the schema; gql2j directives prints their definitions",
+		},
+		{
+			name:   "misspelled directive argument",
+			schema: "directive @javaName(name: String!) on OBJECT\ntype Query @javaName(nmae: \"Root\") { id: ID }",
+			hint:   "did you mean name?",
+		},
+		{
+			name:   "misspelled argument of an injected directive",
+			schema: "type Query { id: ID }\ntype User @key(field: \"id\") { id: ID }",
+			hint:   "did you mean fields?",
+		},
+		{
+			name:   "no close match",
+			schema: "type Query { user: Completely }",
+			hint:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewParser().Parse(tt.schema, "test.graphql")
+			require.Error(t, err)
+
+			var genErr *errors.GeneratorError
+			require.ErrorAs(t, err, &genErr)
+			assert.Equal(t, tt.hint, genErr.Hint)
+		})
+	}
+}
+
+func TestParser_ParseFiles_ErrorLocationInInclude(t *testing.T) {
+	dir := t.TempDir()
+	mainPath := filepath.Join(dir, "main.graphql")
+	extraPath := filepath.Join(dir, "extra.graphql")
+	require.NoError(t, os.WriteFile(mainPath, []byte("type Query { user: User }\ntype User { id: ID }"), 0644))
+	require.NoError(t, os.WriteFile(extraPath, []byte("\nextend type User {\n  profile: Profle\n}\ntype Profile { bio: String }"), 0644))
+
+	_, err := NewParser().ParseFiles([]string{mainPath, extraPath})
+	require.Error(t, err)
+
+	var genErr *errors.GeneratorError
+	require.ErrorAs(t, err, &genErr)
+	assert.Equal(t, &errors.Location{File: extraPath, Line: 3, Column: 12}, genErr.Location)
+	assert.Equal(t, "did you mean Profile?", genErr.Hint)
+}
+
+func TestParser_Parse_OneOf(t *testing.T) {
+	input := `
+type Query { pay(input: PaymentInput!): Boolean }
+input PaymentInput @oneOf {
+  card: String
+  iban: String
+}
+input Address { street: String }
+`
+	p := NewParser()
+	schema, err := p.Parse(input, "schema.graphql")
+	require.NoError(t, err)
+
+	assert.True(t, schema.GetType("PaymentInput").IsOneOf())
+	assert.False(t, schema.GetType("Address").IsOneOf())
+
+	// @oneOf is only allowed on input objects
+	_, err = p.Parse("type Query @oneOf { id: ID }", "schema.graphql")
+	require.Error(t, err)
+}
+
+func TestParser_Parse_OneOfDeclared(t *testing.T) {
+	input := `
+directive @oneOf on INPUT_OBJECT
+type Query { pay(input: PaymentInput!): Boolean }
+input PaymentInput @oneOf { card: String }
+`
+	p := NewParser()
+	schema, err := p.Parse(input, "schema.graphql")
+	require.NoError(t, err)
+	assert.True(t, schema.GetType("PaymentInput").IsOneOf())
+}
+
+func TestParser_Parse_SkipUndeclared(t *testing.T) {
+	input := `
+type Query { user: User, internal: Internal @skip }
+type User { id: ID!, secret: String @skip }
+type Internal @skip { id: ID }
+enum Role { ADMIN, LEGACY @skip(if: true) }
+`
+	schema, err := NewParser().Parse(input, "schema.graphql")
+	require.NoError(t, err)
+
+	assert.True(t, schema.GetType("Internal").HasDirective(DirectiveSkip))
+	assert.True(t, schema.GetType("Query").GetField("internal").HasDirective(DirectiveSkip))
+}
+
+func TestParser_Parse_SkipDeclared(t *testing.T) {
+	input := `
+directive @skip(if: Boolean! = true) on OBJECT | FIELD_DEFINITION | FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+type Query { id: ID }
+type Internal @skip { id: ID }
+`
+	schema, err := NewParser().Parse(input, "schema.graphql")
+	require.NoError(t, err)
+	assert.True(t, schema.GetType("Internal").HasDirective(DirectiveSkip))
+}
+
+func TestParser_Parse_InjectedDefinitions(t *testing.T) {
+	input := `
+type Query { user: User, internal: Internal @skip }
+type User @key(fields: "id") { id: ID! }
+type Internal @skip { id: ID }
+input PaymentInput @oneOf { card: String, iban: String }
+`
+	sources, doc := injectDefinitions([]*ast.Source{{Name: "schema.graphql", Input: input}})
+	require.NotNil(t, doc)
+	var names []string
+	for _, source := range sources[1:] {
+		names = append(names, source.Name)
+	}
+	assert.Equal(t, []string{federationSourceName, skipSourceName, oneOfSourceName}, names)
+	assert.NotNil(t, doc.Directives.ForName("key"))
+	assert.NotNil(t, doc.Directives.ForName(DirectiveOneOf))
+
+	schema, err := NewParser().Parse(input, "schema.graphql")
+	require.NoError(t, err)
+	assert.True(t, schema.GetType("PaymentInput").IsOneOf())
+	assert.True(t, schema.GetType("Internal").HasDirective(DirectiveSkip))
+	assert.True(t, schema.GetType("User").HasDirective(DirectiveKey))
+}
@@ -0,0 +1,470 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+)
+
+// DirectiveArgument describes an argument of a gql2j directive.
+type DirectiveArgument struct {
+	Name        string
+	Kind        ArgumentKind
+	Required    bool
+	Values      []string // Allowed values, or of list items; empty allows any
+	Default     string   // Default value in SDL notation, empty if none
+	Description string
+}
+
+// DirectiveSignature describes a gql2j directive: the arguments it reads and
+// the schema locations it has an effect on.
+type DirectiveSignature struct {
+	Name        string
+	Description string
+	Arguments   []*DirectiveArgument
+	Locations   []ast.DirectiveLocation
+	Repeatable  bool
+}
+
+// Locations shared by several gql2j directives.
+var (
+	fieldLocations = []ast.DirectiveLocation{
+		ast.LocationFieldDefinition,
+		ast.LocationInputFieldDefinition,
+	}
+	namedLocations = []ast.DirectiveLocation{
+		ast.LocationObject,
+		ast.LocationInterface,
+		ast.LocationUnion,
+		ast.LocationEnum,
+		ast.LocationInputObject,
+		ast.LocationFieldDefinition,
+		ast.LocationInputFieldDefinition,
+		ast.LocationEnumValue,
+	}
+)
+
+// directiveSignatures lists the gql2j directives in the order they are
+// documented.
+var directiveSignatures = []*DirectiveSignature{
+	{
+		Name:        DirectiveJavaName,
+		Description: "Overrides the Java name of the generated class, field or enum constant.",
+		Arguments: []*DirectiveArgument{
+			{Name: "name", Kind: ArgumentString, Required: true, Description: "Java identifier to use."},
+		},
+		Locations: namedLocations,
+	},
+	{
+		Name:        DirectiveJavaType,
+		Description: "Overrides the Java type of a field.",
+		Arguments: []*DirectiveArgument{
+			{Name: "type", Kind: ArgumentString, Required: true, Description: "Java type, simple or fully qualified."},
+			{Name: "imports", Kind: ArgumentStringList, Description: "Imports the type needs."},
+		},
+		Locations: fieldLocations,
+	},
+	{
+		Name:        DirectiveAnnotation,
+		Description: "Adds a Java annotation to the generated class, field or enum constant.",
+		Arguments: []*DirectiveArgument{
+			{Name: "value", Kind: ArgumentString, Required: true, Description: "Annotation source, e.g. \"@JsonIgnore\"."},
+			{Name: "imports", Kind: ArgumentStringList, Description: "Imports the annotation needs."},
+		},
+		Locations: []ast.DirectiveLocation{
+			ast.LocationObject,
+			ast.LocationInterface,
+			ast.LocationEnum,
+			ast.LocationInputObject,
+			ast.LocationFieldDefinition,
+			ast.LocationInputFieldDefinition,
+			ast.LocationEnumValue,
+		},
+		Repeatable: true,
+	},
+	{
+		Name:        DirectiveConstraint,
+		Description: "Adds validation constraints to a field.",
+		Arguments: []*DirectiveArgument{
+			{Name: "minLength", Kind: ArgumentInt, Description: "Minimum string length (@Size)."},
+			{Name: "maxLength", Kind: ArgumentInt, Description: "Maximum string length (@Size)."},
+			{Name: "minItems", Kind: ArgumentInt, Description: "Minimum list size (@Size)."},
+			{Name: "maxItems", Kind: ArgumentInt, Description: "Maximum list size (@Size)."},
+			{Name: "min", Kind: ArgumentInt, Description: "Minimum value (@Min)."},
+			{Name: "max", Kind: ArgumentInt, Description: "Maximum value (@Max)."},
+			{Name: "decimalMin", Kind: ArgumentString, Description: "Minimum decimal value, e.g. \"0.01\" (@DecimalMin)."},
+			{Name: "decimalMax", Kind: ArgumentString, Description: "Maximum decimal value (@DecimalMax)."},
+			{Name: "positive", Kind: ArgumentBoolean, Description: "Adds @Positive."},
+			{Name: "positiveOrZero", Kind: ArgumentBoolean, Description: "Adds @PositiveOrZero."},
+			{Name: "negative", Kind: ArgumentBoolean, Description: "Adds @Negative."},
+			{Name: "negativeOrZero", Kind: ArgumentBoolean, Description: "Adds @NegativeOrZero."},
+			{Name: "integerDigits", Kind: ArgumentInt, Description: "Maximum integer digits (@Digits)."},
+			{Name: "fractionDigits", Kind: ArgumentInt, Description: "Maximum fraction digits (@Digits), 0 by default."},
+			{Name: "past", Kind: ArgumentBoolean, Description: "Adds @Past."},
+			{Name: "pastOrPresent", Kind: ArgumentBoolean, Description: "Adds @PastOrPresent."},
+			{Name: "future", Kind: ArgumentBoolean, Description: "Adds @Future."},
+			{Name: "futureOrPresent", Kind: ArgumentBoolean, Description: "Adds @FutureOrPresent."},
+			{Name: "pattern", Kind: ArgumentString, Description: "Regular expression (@Pattern)."},
+			{Name: "patternFlags", Kind: ArgumentStringList, Values: patternFlagNames, Description: "Flags of the pattern."},
+			{Name: "notNull", Kind: ArgumentBoolean, Description: "Adds @NotNull."},
+			{Name: "notBlank", Kind: ArgumentBoolean, Description: "Adds @NotBlank."},
+			{Name: "notEmpty", Kind: ArgumentBoolean, Description: "Adds @NotEmpty."},
+			{Name: "email", Kind: ArgumentBoolean, Description: "Adds @Email."},
+			{Name: "message", Kind: ArgumentString, Description: "Message of the constraints."},
+			{Name: "groups", Kind: ArgumentStringList, Description: "Validation group classes of the constraints."},
+		},
+		Locations: fieldLocations,
+	},
+	{
+		Name:        DirectiveLombok,
+		Description: "Adjusts the Lombok annotations of a class.",
+		Arguments: []*DirectiveArgument{
+			{Name: "exclude", Kind: ArgumentStringList, Values: lombokAnnotationNames, Description: "Annotations to leave out."},
+			{Name: "include", Kind: ArgumentStringList, Values: lombokAnnotationNames, Description: "Annotations to add."},
+		},
+		Locations: []ast.DirectiveLocation{ast.LocationObject, ast.LocationInputObject},
+	},
+	{
+		Name:        DirectiveCollection,
+		Description: "Selects the Java collection type of a list field.",
+		Arguments: []*DirectiveArgument{
+			{Name: "type", Kind: ArgumentString, Required: true, Values: collectionTypeNames, Description: "Collection type."},
+		},
+		Locations: fieldLocations,
+	},
+	{
+		Name:        DirectiveEntity,
+		Description: "Generates a JPA entity for the object type.",
+		Arguments: []*DirectiveArgument{
+			{Name: "table", Kind: ArgumentString, Description: "Name of the table (@Table)."},
+		},
+		Locations: []ast.DirectiveLocation{ast.LocationObject},
+	},
+	{
+		Name:        DirectiveID,
+		Description: "Marks the identifier field of a JPA entity (@Id).",
+		Locations:   []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	{
+		Name:        DirectiveColumn,
+		Description: "Maps a field of a JPA entity to a column (@Column), or a relationship to a join column (@JoinColumn).",
+		Arguments: []*DirectiveArgument{
+			{Name: "name", Kind: ArgumentString, Description: "Name of the column."},
+			{Name: "length", Kind: ArgumentInt, Description: "Length of a string column."},
+		},
+		Locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	{
+		Name:        DirectiveRelation,
+		Description: "Sets the kind of the relationship of a JPA entity field referencing another entity.",
+		Arguments: []*DirectiveArgument{
+			{Name: "kind", Kind: ArgumentString, Required: true, Values: relationKindNames, Description: "Kind of relationship."},
+			{Name: "mappedBy", Kind: ArgumentString, Description: "Field of the other entity owning the relationship."},
+		},
+		Locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	{
+		Name:        DirectiveTransient,
+		Description: "Excludes a field of a JPA entity from persistence (@Transient).",
+		Locations:   []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	{
+		Name:        DirectiveSkip,
+		Description: "Excludes a type, field or enum value from generation. Also usable in queries.",
+		Arguments: []*DirectiveArgument{
+			{Name: "if", Kind: ArgumentBoolean, Default: "true", Description: "Skips only if true."},
+		},
+		Locations: append(namedLocations[:len(namedLocations):len(namedLocations)],
+			ast.LocationField, ast.LocationFragmentSpread, ast.LocationInlineFragment),
+	},
+}
+
+// lombokAnnotationNames are the names accepted by @lombok(exclude, include).
+var lombokAnnotationNames = []string{
+	"allArgsConstructor", "builder", "data", "equalsAndHashCode", "getter",
+	"noArgsConstructor", "setter", "superBuilder", "toString", "value",
+}
+
+// patternFlagNames are the flags accepted by @constraint(patternFlags).
+var patternFlagNames = []string{
+	"CANON_EQ", "CASE_INSENSITIVE", "COMMENTS", "DOTALL", "MULTILINE", "UNICODE_CASE", "UNIX_LINES",
+}
+
+// relationKindNames are the kinds accepted by @relation(kind).
+var relationKindNames = []string{RelationOneToOne, RelationOneToMany, RelationManyToOne, RelationManyToMany}
+
+// collectionTypeNames are the types accepted by @collection(type).
+var collectionTypeNames = []string{"Collection", "LinkedList", "List", "Set", "SortedSet"}
+
+// GeneratorDirectives returns the signatures of all gql2j directives.
+func GeneratorDirectives() []*DirectiveSignature {
+	signatures := make([]*DirectiveSignature, len(directiveSignatures))
+	copy(signatures, directiveSignatures)
+	return signatures
+}
+
+// LookupDirective returns the signature of the named gql2j directive, or nil
+// if it is not a gql2j directive.
+func LookupDirective(name string) *DirectiveSignature {
+	for _, s := range directiveSignatures {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// Argument returns the named argument, or nil if the directive has no such
+// argument.
+func (s *DirectiveSignature) Argument(name string) *DirectiveArgument {
+	for _, arg := range s.Arguments {
+		if arg.Name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+// AllowsLocation returns true if the directive may be used at the location.
+func (s *DirectiveSignature) AllowsLocation(location ast.DirectiveLocation) bool {
+	for _, l := range s.Locations {
+		if l == location {
+			return true
+		}
+	}
+	return false
+}
+
+// SDL returns the directive definition in schema definition language.
+func (s *DirectiveSignature) SDL() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%q\ndirective @%s", s.Description, s.Name)
+
+	if len(s.Arguments) > 0 {
+		b.WriteString("(\n")
+		for _, arg := range s.Arguments {
+			description := arg.Description
+			if len(arg.Values) > 0 {
+				description += " One of: " + strings.Join(arg.Values, ", ") + "."
+			}
+			fmt.Fprintf(&b, "  %q\n  %s: %s", description, arg.Name, arg.Kind)
+			if arg.Required || arg.Default != "" {
+				b.WriteString("!")
+			}
+			if arg.Default != "" {
+				b.WriteString(" = " + arg.Default)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(")")
+	}
+
+	if s.Repeatable {
+		b.WriteString(" repeatable")
+	}
+
+	locations := make([]string, len(s.Locations))
+	for i, l := range s.Locations {
+		locations[i] = string(l)
+	}
+	b.WriteString(" on " + strings.Join(locations, " | ") + "\n")
+	return b.String()
+}
+
+// DirectivesSDL returns the definitions of the gql2j directives, ready to be
+// included in a schema. @skip is left out: it is built in, and GraphQL
+// servers reject schemas redefining it. The parser accepts it on schema
+// elements without a definition.
+func DirectivesSDL() string {
+	var definitions []string
+	for _, s := range directiveSignatures {
+		if s.Name != DirectiveSkip {
+			definitions = append(definitions, s.SDL())
+		}
+	}
+	return strings.Join(definitions, "\n")
+}
+
+// TypeLocation returns the directive location of a type of the given kind.
+func TypeLocation(kind TypeKind) ast.DirectiveLocation {
+	return ast.DirectiveLocation(kind)
+}
+
+// FieldLocation returns the directive location of a field of a type of the
+// given kind.
+func FieldLocation(kind TypeKind) ast.DirectiveLocation {
+	if kind == TypeKindInputObject {
+		return ast.LocationInputFieldDefinition
+	}
+	return ast.LocationFieldDefinition
+}
+
+// ValidateTypeDirectives validates the gql2j directives used on a type, its
+// fields and its enum values.
+func ValidateTypeDirectives(t *TypeDef) []*errors.DirectiveError {
+	var result []*errors.DirectiveError
+	for _, err := range ValidateDirectives(t.Directives, TypeLocation(t.Kind)) {
+		result = append(result, err.WithTypeName(t.Name))
+	}
+	for _, f := range t.Fields {
+		for _, err := range ValidateDirectives(f.Directives, FieldLocation(t.Kind)) {
+			result = append(result, err.WithTypeName(t.Name).WithFieldName(f.Name))
+		}
+	}
+	for _, ev := range t.EnumValues {
+		for _, err := range ValidateDirectives(ev.Directives, ast.LocationEnumValue) {
+			result = append(result, err.WithTypeName(t.Name))
+		}
+	}
+	return result
+}
+
+// ValidateDirectives validates directives used at a schema location against
+// the gql2j directive signatures. It reports gql2j directives used where they
+// have no effect, unknown, mistyped or missing arguments, values outside the
+// allowed set, repeated directives that are not repeatable, and directives
+// whose name looks like a misspelled gql2j directive. Other directives are
+// not checked. The returned errors carry the location of the directive.
+func ValidateDirectives(directives []*DirectiveDef, location ast.DirectiveLocation) []*errors.DirectiveError {
+	var result []*errors.DirectiveError
+	report := func(d *DirectiveDef, hint, format string, args ...interface{}) {
+		err := errors.NewDirectiveError(fmt.Sprintf(format, args...), nil).WithDirective(d.Name)
+		err.Location = d.Location
+		err.Hint = hint
+		result = append(result, err)
+	}
+
+	seen := make(map[string]bool)
+	for _, d := range directives {
+		signature := LookupDirective(d.Name)
+		if signature == nil {
+			if match := closestDirective(d.Name); match != "" {
+				report(d, fmt.Sprintf("did you mean @%s?", match), "@%s is not a gql2j directive", d.Name)
+			}
+			continue
+		}
+
+		if !signature.AllowsLocation(location) {
+			report(d, "allowed on "+signature.typeSystemLocations(), "@%s cannot be used on %s", d.Name, location)
+			continue
+		}
+		if seen[d.Name] && !signature.Repeatable {
+			report(d, "", "@%s cannot be used more than once here", d.Name)
+		}
+		seen[d.Name] = true
+
+		for _, name := range sortedArgumentNames(d.Arguments) {
+			arg := signature.Argument(name)
+			if arg == nil {
+				report(d, signature.argumentHint(name), "@%s has no argument %q", d.Name, name)
+				continue
+			}
+			value := d.Arguments[name]
+			if !arg.Kind.Matches(value) {
+				report(d, "", "argument %q of @%s must be %s", name, d.Name, arg.Kind)
+				continue
+			}
+			for _, v := range arg.invalidValues(value) {
+				hint := "valid values: " + strings.Join(arg.Values, ", ")
+				if match := errors.ClosestMatch(v, arg.Values); match != "" {
+					hint = fmt.Sprintf("did you mean %q?", match)
+				}
+				report(d, hint, "%q is not a valid value for argument %q of @%s", v, name, d.Name)
+			}
+		}
+
+		for _, arg := range signature.Arguments {
+			if _, ok := d.Arguments[arg.Name]; arg.Required && !ok {
+				report(d, "", "@%s requires argument %q", d.Name, arg.Name)
+			}
+		}
+	}
+	return result
+}
+
+// invalidValues returns the values, or list items, of value that are not in
+// the allowed values of the argument.
+func (a *DirectiveArgument) invalidValues(value interface{}) []string {
+	if len(a.Values) == 0 {
+		return nil
+	}
+
+	var items []string
+	switch v := value.(type) {
+	case string:
+		items = []string{v}
+	case []interface{}:
+		for _, item := range v {
+			items = append(items, item.(string))
+		}
+	}
+
+	var invalid []string
+	for _, item := range items {
+		if !containsString(a.Values, item) {
+			invalid = append(invalid, item)
+		}
+	}
+	return invalid
+}
+
+// argumentHint suggests the argument meant by an unknown argument name.
+func (s *DirectiveSignature) argumentHint(name string) string {
+	names := make([]string, len(s.Arguments))
+	for i, arg := range s.Arguments {
+		names[i] = arg.Name
+	}
+	if match := errors.ClosestMatch(name, names); match != "" {
+		return fmt.Sprintf("did you mean %s?", match)
+	}
+	return "valid arguments: " + strings.Join(names, ", ")
+}
+
+// typeSystemLocations returns the schema locations of the directive for
+// messages, leaving out locations in queries.
+func (s *DirectiveSignature) typeSystemLocations() string {
+	var locations []string
+	for _, l := range s.Locations {
+		switch l {
+		case ast.LocationField, ast.LocationFragmentSpread, ast.LocationInlineFragment:
+			continue
+		}
+		locations = append(locations, string(l))
+	}
+	return strings.Join(locations, ", ")
+}
+
+// closestDirective returns the gql2j directive a directive name is likely a
+// misspelling of. Built-in and federation directives are never reported.
+func closestDirective(name string) string {
+	if name == DirectiveDeprecated || name == "include" || name == "specifiedBy" || IsFederationDirective(name) {
+		return ""
+	}
+	names := make([]string, len(directiveSignatures))
+	for i, s := range directiveSignatures {
+		names[i] = s.Name
+	}
+	return errors.ClosestMatch(name, names)
+}
+
+func sortedArgumentNames(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
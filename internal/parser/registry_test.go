@@ -0,0 +1,252 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestDirectivesSDL_DeclaresUsableDirectives(t *testing.T) {
+	input := DirectivesSDL() + `
+type Query {
+  user: User @skip
+}
+
+type User @javaName(name: "Account") @lombok(exclude: ["builder"]) @annotation(value: "@A") @annotation(value: "@B") {
+  id: ID! @javaType(type: "java.util.UUID", imports: ["java.util.UUID"])
+  email: String @constraint(email: true, maxLength: 255)
+  tags: [String] @collection(type: "Set")
+}
+
+enum Role { ADMIN @javaName(name: "ADMINISTRATOR"), GUEST @skip(if: false) }
+
+type Post @entity(table: "posts") {
+  id: ID! @id
+  title: String @column(name: "post_title", length: 200)
+  author: User @relation(kind: "MANY_TO_ONE")
+  score: Int @transient
+}
+`
+	schema, err := NewParser().Parse(input, "schema.graphql")
+	require.NoError(t, err)
+
+	for _, name := range []string{"User", "Role", "Post"} {
+		assert.Empty(t, ValidateTypeDirectives(schema.GetType(name)), name)
+	}
+	assert.Contains(t, schema.Directives, DirectiveCollection)
+	assert.True(t, schema.GetType("Query").GetField("user").HasDirective(DirectiveSkip))
+}
+
+func TestDirectivesSDL_OmitsBuiltinSkip(t *testing.T) {
+	assert.NotContains(t, DirectivesSDL(), "directive @skip")
+	assert.Contains(t, DirectivesSDL(), "directive @javaName")
+	assert.NotNil(t, LookupDirective(DirectiveSkip))
+}
+
+func TestDirectiveSignature_SDL(t *testing.T) {
+	sdl := LookupDirective(DirectiveCollection).SDL()
+
+	assert.Equal(t, `"Selects the Java collection type of a list field."
+directive @collection(
+  "Collection type. One of: Collection, LinkedList, List, Set, SortedSet."
+  type: String!
+) on FIELD_DEFINITION | INPUT_FIELD_DEFINITION
+`, sdl)
+	assert.Contains(t, LookupDirective(DirectiveAnnotation).SDL(), ") repeatable on OBJECT")
+	assert.Contains(t, LookupDirective(DirectiveSkip).SDL(), "if: Boolean! = true")
+}
+
+func TestLookupDirective(t *testing.T) {
+	assert.Nil(t, LookupDirective(DirectiveDeprecated))
+	assert.Len(t, GeneratorDirectives(), 12)
+
+	signature := LookupDirective(DirectiveJavaType)
+	require.NotNil(t, signature)
+	assert.True(t, signature.AllowsLocation(ast.LocationInputFieldDefinition))
+	assert.False(t, signature.AllowsLocation(ast.LocationObject))
+	assert.True(t, signature.Argument("type").Required)
+	assert.Nil(t, signature.Argument("name"))
+}
+
+func TestValidateDirectives(t *testing.T) {
+	tests := []struct {
+		name       string
+		directives []*DirectiveDef
+		location   ast.DirectiveLocation
+		messages   []string
+		hint       string
+	}{
+		{
+			name:       "valid",
+			directives: []*DirectiveDef{{Name: DirectiveConstraint, Arguments: map[string]interface{}{"min": int64(1), "pattern": "^a"}}},
+			location:   ast.LocationFieldDefinition,
+		},
+		{
+			name:       "unknown argument",
+			directives: []*DirectiveDef{{Name: DirectiveConstraint, Arguments: map[string]interface{}{"minLen": int64(3)}}},
+			location:   ast.LocationFieldDefinition,
+			messages:   []string{`@constraint has no argument "minLen"`},
+			hint:       "did you mean minLength?",
+		},
+		{
+			name:       "unknown argument without close match",
+			directives: []*DirectiveDef{{Name: DirectiveJavaType, Arguments: map[string]interface{}{"type": "UUID", "package": "java.util"}}},
+			location:   ast.LocationFieldDefinition,
+			messages:   []string{`@javaType has no argument "package"`},
+			hint:       "valid arguments: type, imports",
+		},
+		{
+			name:       "wrong type",
+			directives: []*DirectiveDef{{Name: DirectiveConstraint, Arguments: map[string]interface{}{"notNull": "yes"}}},
+			location:   ast.LocationInputFieldDefinition,
+			messages:   []string{`argument "notNull" of @constraint must be Boolean`},
+		},
+		{
+			name:       "value not allowed",
+			directives: []*DirectiveDef{{Name: DirectiveCollection, Arguments: map[string]interface{}{"type": "Vector"}}},
+			location:   ast.LocationFieldDefinition,
+			messages:   []string{`"Vector" is not a valid value for argument "type" of @collection`},
+			hint:       "valid values: Collection, LinkedList, List, Set, SortedSet",
+		},
+		{
+			name: "list item not allowed",
+			directives: []*DirectiveDef{{Name: DirectiveLombok, Arguments: map[string]interface{}{
+				"include": []interface{}{"builder", "toStrng"},
+			}}},
+			location: ast.LocationObject,
+			messages: []string{`"toStrng" is not a valid value for argument "include" of @lombok`},
+			hint:     `did you mean "toString"?`,
+		},
+		{
+			name:       "missing required argument",
+			directives: []*DirectiveDef{{Name: DirectiveJavaName}},
+			location:   ast.LocationEnumValue,
+			messages:   []string{`@javaName requires argument "name"`},
+		},
+		{
+			name:       "location not allowed",
+			directives: []*DirectiveDef{{Name: DirectiveCollection, Arguments: map[string]interface{}{"type": "Vector"}}},
+			location:   ast.LocationObject,
+			messages:   []string{"@collection cannot be used on OBJECT"},
+			hint:       "allowed on FIELD_DEFINITION, INPUT_FIELD_DEFINITION",
+		},
+		{
+			name: "repeated",
+			directives: []*DirectiveDef{
+				{Name: DirectiveJavaName, Arguments: map[string]interface{}{"name": "A"}},
+				{Name: DirectiveJavaName, Arguments: map[string]interface{}{"name": "B"}},
+			},
+			location: ast.LocationObject,
+			messages: []string{"@javaName cannot be used more than once here"},
+		},
+		{
+			name: "repeatable",
+			directives: []*DirectiveDef{
+				{Name: DirectiveAnnotation, Arguments: map[string]interface{}{"value": "@A"}},
+				{Name: DirectiveAnnotation, Arguments: map[string]interface{}{"value": "@B"}},
+			},
+			location: ast.LocationEnumValue,
+		},
+		{
+			name:       "misspelled directive",
+			directives: []*DirectiveDef{{Name: "colection"}},
+			location:   ast.LocationFieldDefinition,
+			messages:   []string{"@colection is not a gql2j directive"},
+			hint:       "did you mean @collection?",
+		},
+		{
+			name:       "other directives",
+			directives: []*DirectiveDef{{Name: "cache"}, {Name: DirectiveDeprecated}, {Name: "key"}},
+			location:   ast.LocationObject,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateDirectives(tt.directives, tt.location)
+
+			var messages []string
+			for _, err := range errs {
+				messages = append(messages, err.Message)
+			}
+			assert.Equal(t, tt.messages, messages)
+			if tt.hint != "" {
+				require.NotEmpty(t, errs)
+				assert.Equal(t, tt.hint, errs[0].Hint)
+			}
+		})
+	}
+}
+
+func TestValidateTypeDirectives_Locations(t *testing.T) {
+	input := `
+directive @javaType(type: String) on OBJECT | FIELD_DEFINITION | INPUT_FIELD_DEFINITION
+type User @javaType(type: "Account") {
+  id: ID @javaType(type: "UUID")
+}
+input UserInput {
+  id: ID @javaType
+}
+`
+	schema, err := NewParser().Parse(input, "schema.graphql")
+	require.NoError(t, err)
+
+	errs := ValidateTypeDirectives(schema.GetType("User"))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "@javaType cannot be used on OBJECT", errs[0].Message)
+	assert.Equal(t, "User", errs[0].TypeName)
+	assert.Equal(t, "javaType", errs[0].DirectiveName)
+	require.NotNil(t, errs[0].Location)
+	assert.Equal(t, "schema.graphql", errs[0].Location.File)
+	assert.Equal(t, 3, errs[0].Location.Line)
+
+	errs = ValidateTypeDirectives(schema.GetType("UserInput"))
+	require.Len(t, errs, 1)
+	assert.Equal(t, `@javaType requires argument "type"`, errs[0].Message)
+	assert.Equal(t, "id", errs[0].FieldName)
+}
+
+func TestExtractSkipDirective_IfFalse(t *testing.T) {
+	assert.NotNil(t, ExtractSkipDirective([]*DirectiveDef{{Name: DirectiveSkip}}))
+	assert.NotNil(t, ExtractSkipDirective([]*DirectiveDef{{Name: DirectiveSkip, Arguments: map[string]interface{}{"if": true}}}))
+	assert.Nil(t, ExtractSkipDirective([]*DirectiveDef{{Name: DirectiveSkip, Arguments: map[string]interface{}{"if": false}}}))
+}
+
+func TestExtractConstraintDirective(t *testing.T) {
+	info := ExtractConstraintDirective([]*DirectiveDef{{Name: DirectiveConstraint, Arguments: map[string]interface{}{
+		"minItems":       int64(1),
+		"decimalMin":     "0.01",
+		"positiveOrZero": true,
+		"integerDigits":  int64(6),
+		"pastOrPresent":  true,
+		"patternFlags":   []interface{}{"DOTALL"},
+		"notEmpty":       true,
+		"message":        "invalid",
+		"groups":         []interface{}{"com.example.OnCreate"},
+	}}})
+	require.NotNil(t, info)
+
+	assert.Equal(t, 1, *info.MinItems)
+	assert.Nil(t, info.MaxItems)
+	assert.Equal(t, "0.01", info.DecimalMin)
+	assert.True(t, info.PositiveOrZero)
+	assert.Equal(t, 6, *info.IntegerDigits)
+	assert.Nil(t, info.FractionDigits)
+	assert.True(t, info.PastOrPresent)
+	assert.Equal(t, []string{"DOTALL"}, info.PatternFlags)
+	assert.True(t, info.NotEmpty)
+	assert.Equal(t, "invalid", info.Message)
+	assert.Equal(t, []string{"com.example.OnCreate"}, info.Groups)
+}
+
+func TestValidateDirectives_PatternFlags(t *testing.T) {
+	errs := ValidateDirectives([]*DirectiveDef{{Name: DirectiveConstraint, Arguments: map[string]interface{}{
+		"pattern":      "^a",
+		"patternFlags": []interface{}{"CASE_INSENSITVE"},
+	}}}, "FIELD_DEFINITION")
+	require.Len(t, errs, 1)
+	assert.Equal(t, `"CASE_INSENSITVE" is not a valid value for argument "patternFlags" of @constraint`, errs[0].Message)
+	assert.Equal(t, `did you mean "CASE_INSENSITIVE"?`, errs[0].Hint)
+}
@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// skipSourceName is the source name of the injected @skip definition.
+const skipSourceName = "<skip>"
+
+// skipSource returns a source redefining the built-in @skip with the schema
+// locations gql2j accepts it at, if the schema uses it on a type, field or
+// enum value without declaring it, or nil. GraphQL servers reject
+// redefinitions of built-in directives, so schemas are not expected to
+// declare it.
+func skipSource(doc *ast.SchemaDocument) *ast.Source {
+	for _, d := range doc.Directives {
+		if d.Name == DirectiveSkip {
+			return nil
+		}
+	}
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		if usesSkip(def) {
+			return &ast.Source{Name: skipSourceName, Input: LookupDirective(DirectiveSkip).SDL()}
+		}
+	}
+	return nil
+}
+
+// usesSkip returns true if @skip is used on the definition, its fields or
+// its enum values.
+func usesSkip(def *ast.Definition) bool {
+	if def.Directives.ForName(DirectiveSkip) != nil {
+		return true
+	}
+	for _, field := range def.Fields {
+		if field.Directives.ForName(DirectiveSkip) != nil {
+			return true
+		}
+	}
+	for _, value := range def.EnumValues {
+		if value.Directives.ForName(DirectiveSkip) != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -18,14 +18,15 @@ const (
 
 // TypeDef represents a parsed GraphQL type definition.
 type TypeDef struct {
-	Name        string
-	Kind        TypeKind
-	Description string
-	Fields      []*FieldDef
-	EnumValues  []*EnumValueDef
-	Interfaces  []string
-	Directives  []*DirectiveDef
-	Location    *errors.Location
+	Name          string
+	Kind          TypeKind
+	Description   string
+	Fields        []*FieldDef
+	EnumValues    []*EnumValueDef
+	Interfaces    []string
+	PossibleTypes []string // Member types of a union
+	Directives    []*DirectiveDef
+	Location      *errors.Location
 }
 
 // FieldDef represents a parsed field definition.
@@ -64,6 +65,21 @@ func (t *TypeRef) NamedType() string {
 	return t.Name
 }
 
+// String returns the type in GraphQL notation, e.g. "[String!]!".
+func (t *TypeRef) String() string {
+	if t == nil {
+		return ""
+	}
+	s := t.Name
+	if t.Elem != nil {
+		s = "[" + t.Elem.String() + "]"
+	}
+	if t.NonNull {
+		s += "!"
+	}
+	return s
+}
+
 // EnumValueDef represents a parsed enum value.
 type EnumValueDef struct {
 	Name        string
@@ -92,6 +108,11 @@ type DirectiveDef struct {
 type Schema struct {
 	Types      map[string]*TypeDef
 	Directives map[string]*DirectiveDefinition
+
+	// Root operation type names; empty if the schema has no such root.
+	QueryType        string
+	MutationType     string
+	SubscriptionType string
 }
 
 // DirectiveDefinition represents the definition of a directive.
@@ -110,6 +131,13 @@ func (s *Schema) GetType(name string) *TypeDef {
 	return s.Types[name]
 }
 
+// IsRootType returns true if the named type is a query, mutation or
+// subscription root.
+func (s *Schema) IsRootType(name string) bool {
+	return name != "" &&
+		(name == s.QueryType || name == s.MutationType || name == s.SubscriptionType)
+}
+
 // ObjectTypes returns all object types.
 func (s *Schema) ObjectTypes() []*TypeDef {
 	return s.TypesByKind(TypeKindObject)
@@ -141,6 +169,16 @@ func (s *Schema) TypesByKind(kind TypeKind) []*TypeDef {
 	return result
 }
 
+// GetField returns the field with the given name, or nil.
+func (t *TypeDef) GetField(name string) *FieldDef {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
 // HasDirective checks if the type has a specific directive.
 func (t *TypeDef) HasDirective(name string) bool {
 	for _, d := range t.Directives {
@@ -151,6 +189,12 @@ func (t *TypeDef) HasDirective(name string) bool {
 	return false
 }
 
+// IsOneOf returns true if the type is a @oneOf input object, of which
+// exactly one field must be set.
+func (t *TypeDef) IsOneOf() bool {
+	return t.Kind == TypeKindInputObject && t.HasDirective(DirectiveOneOf)
+}
+
 // GetDirective returns the directive with the given name, or nil.
 func (t *TypeDef) GetDirective(name string) *DirectiveDef {
 	for _, d := range t.Directives {
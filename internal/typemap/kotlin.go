@@ -0,0 +1,135 @@
+package typemap
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/source-c/go-gql2j/internal/errors"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+// kotlinTypes maps the Java types Kotlin has its own types for to them.
+var kotlinTypes = map[string]string{
+	"boolean": "Boolean", "Boolean": "Boolean",
+	"byte": "Byte", "Byte": "Byte",
+	"char": "Char", "Character": "Char",
+	"double": "Double", "Double": "Double",
+	"float": "Float", "Float": "Float",
+	"int": "Int", "Integer": "Int",
+	"long": "Long", "Long": "Long",
+	"short": "Short", "Short": "Short",
+	"Object":               "Any",
+	"String":               "String",
+	"Number":               "Number",
+	"java.util.Collection": "Collection",
+	"java.util.List":       "List",
+	"java.util.Map":        "Map",
+	"java.util.Set":        "Set",
+}
+
+// javaTypeNamePattern matches the simple and qualified type names in a Java
+// type, and the array brackets following them.
+var javaTypeNamePattern = regexp.MustCompile(`[A-Za-z_][\w.]*(\[\])*`)
+
+// KotlinType converts a Java type to Kotlin: primitives, their wrappers,
+// Object and the Java collections become the Kotlin types, and arrays become
+// Kotlin arrays, e.g. Map<String, Any> for Map<String, Object>.
+func KotlinType(javaType string) string {
+	return javaTypeNamePattern.ReplaceAllStringFunc(javaType, func(name string) string {
+		dims := strings.Count(name, "[]")
+		name = strings.TrimSuffix(name, strings.Repeat("[]", dims))
+		primitive := IsPrimitive(name)
+		if kotlin, ok := kotlinTypes[strings.TrimPrefix(name, "java.lang.")]; ok {
+			name = kotlin
+		}
+		for i := 0; i < dims; i++ {
+			if i == 0 && primitive {
+				name += "Array"
+			} else {
+				name = "Array<" + name + ">"
+			}
+		}
+		return name
+	})
+}
+
+// KotlinImports returns the imports of a Java type that Kotlin code needs:
+// java.lang types and the types mapped to Kotlin types are not imported.
+func KotlinImports(imports []string) []string {
+	var result []string
+	for _, imp := range imports {
+		if _, ok := kotlinTypes[imp]; ok || strings.HasPrefix(imp, "java.lang.") {
+			continue
+		}
+		result = append(result, imp)
+	}
+	return result
+}
+
+// MapKotlinType maps a GraphQL type reference to Kotlin. The mapped type is
+// in the JavaType of the result. Nullable types are marked with ?, and lists
+// are read-only Kotlin collections of the configured collection type.
+func (tm *TypeMapper) MapKotlinType(typeRef *parser.TypeRef) (*MapResult, error) {
+	return tm.mapKotlinType(typeRef, tm.config.Java.CollectionType)
+}
+
+// MapKotlinFieldType maps a field type to Kotlin, considering @javaType and
+// @collection.
+func (tm *TypeMapper) MapKotlinFieldType(field *parser.FieldDef) (*MapResult, error) {
+	if javaType := parser.ExtractJavaTypeDirective(field.Directives); javaType != nil {
+		result := &MapResult{
+			JavaType: KotlinType(javaType.Type),
+			Imports:  KotlinImports(javaType.Imports),
+		}
+		if field.Type == nil || !field.Type.NonNull {
+			result.JavaType += "?"
+		}
+		return result, nil
+	}
+
+	collectionType := tm.config.Java.CollectionType
+	if override := parser.ExtractCollectionDirective(field.Directives); override != nil {
+		collectionType = override.Type
+	}
+	return tm.mapKotlinType(field.Type, collectionType)
+}
+
+func (tm *TypeMapper) mapKotlinType(typeRef *parser.TypeRef, collectionType string) (*MapResult, error) {
+	if typeRef == nil {
+		return &MapResult{JavaType: "Any?"}, nil
+	}
+
+	var result *MapResult
+	if typeRef.IsList() {
+		elemResult, err := tm.mapKotlinType(typeRef.Elem, tm.config.Java.CollectionType)
+		if err != nil {
+			return nil, errors.NewTypeMappingError(
+				"failed to map list element type",
+				err,
+			).WithSourceType(typeRef.Elem.Name)
+		}
+		result = &MapResult{
+			JavaType:     collectionType + "<" + elemResult.JavaType + ">",
+			Imports:      elemResult.Imports,
+			IsCollection: true,
+			ElementType:  elemResult.JavaType,
+		}
+	} else {
+		named, err := tm.mapNamedType(typeRef.Name)
+		if err != nil {
+			return nil, errors.NewTypeMappingError(
+				"failed to map named type",
+				err,
+			).WithSourceType(typeRef.Name)
+		}
+		result = &MapResult{
+			JavaType: KotlinType(named.JavaType),
+			Imports:  KotlinImports(named.Imports),
+		}
+	}
+
+	if !typeRef.NonNull {
+		result.JavaType += "?"
+	}
+	return result, nil
+}
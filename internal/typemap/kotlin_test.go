@@ -0,0 +1,65 @@
+package typemap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/source-c/go-gql2j/internal/config"
+	"github.com/source-c/go-gql2j/internal/parser"
+)
+
+func TestKotlinType(t *testing.T) {
+	tests := []struct {
+		javaType string
+		expected string
+	}{
+		{"Integer", "Int"},
+		{"int", "Int"},
+		{"java.lang.Object", "Any"},
+		{"java.time.OffsetDateTime", "java.time.OffsetDateTime"},
+		{"Map<String, Object>", "Map<String, Any>"},
+		{"java.util.List<Long>", "List<Long>"},
+		{"byte[]", "ByteArray"},
+		{"String[][]", "Array<Array<String>>"},
+		{"int[][]", "Array<IntArray>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.javaType, func(t *testing.T) {
+			assert.Equal(t, tt.expected, KotlinType(tt.javaType))
+		})
+	}
+}
+
+func TestKotlinImports(t *testing.T) {
+	imports := KotlinImports([]string{"java.util.Map", "java.lang.Object", "java.time.LocalDate", "java.util.UUID"})
+
+	assert.Equal(t, []string{"java.time.LocalDate", "java.util.UUID"}, imports)
+}
+
+func TestTypeMapper_MapKotlinType(t *testing.T) {
+	tm := NewTypeMapper(config.DefaultConfig())
+
+	tests := []struct {
+		name     string
+		typeRef  *parser.TypeRef
+		expected string
+	}{
+		{"nullable Int", &parser.TypeRef{Name: "Int"}, "Int?"},
+		{"non-null Int", &parser.TypeRef{Name: "Int", NonNull: true}, "Int"},
+		{"nullable list of non-null", &parser.TypeRef{Elem: &parser.TypeRef{Name: "String", NonNull: true}}, "List<String>?"},
+		{"non-null list of nullable", &parser.TypeRef{Elem: &parser.TypeRef{Name: "Float"}, NonNull: true}, "List<Double?>"},
+		{"object", &parser.TypeRef{Name: "User", NonNull: true}, "User"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tm.MapKotlinType(tt.typeRef)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.JavaType)
+			assert.Empty(t, result.Imports)
+		})
+	}
+}
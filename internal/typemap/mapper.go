@@ -1,6 +1,11 @@
 package typemap
 
 import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/source-c/go-gql2j/internal/annotations"
 	"github.com/source-c/go-gql2j/internal/config"
 	"github.com/source-c/go-gql2j/internal/errors"
 	"github.com/source-c/go-gql2j/internal/parser"
@@ -12,6 +17,9 @@ type TypeMapper struct {
 	customScalars  map[string]ScalarInfo
 	builtinScalars map[string]ScalarInfo
 	schemaTypes    map[string]*parser.TypeDef
+	typeNames      map[string]string
+	nullability    *annotations.NullabilityGenerator
+	validation     *annotations.ValidationGenerator
 }
 
 // NewTypeMapper creates a new TypeMapper with the given configuration.
@@ -21,6 +29,8 @@ func NewTypeMapper(cfg *config.Config) *TypeMapper {
 		customScalars:  make(map[string]ScalarInfo),
 		builtinScalars: BuiltinScalars(),
 		schemaTypes:    make(map[string]*parser.TypeDef),
+		nullability:    annotations.NewNullabilityGenerator(&cfg.Java),
+		validation:     annotations.NewValidationGenerator(&cfg.Features.Validation),
 	}
 
 	// Load custom scalar mappings from config
@@ -39,7 +49,17 @@ func (tm *TypeMapper) SetSchemaTypes(types map[string]*parser.TypeDef) {
 	tm.schemaTypes = types
 }
 
-// MapResult contains the result of a type mapping.
+// SetTypeNames sets Java names of schema types that take precedence over
+// @javaName and the naming conventions, e.g. names chosen to resolve
+// collisions.
+func (tm *TypeMapper) SetTypeNames(names map[string]string) {
+	tm.typeNames = names
+}
+
+// MapResult contains the result of a type mapping. JavaType carries no
+// annotations; AnnotatedType is the same type with the type-use nullness
+// annotations and container-element constraints of collection elements,
+// which only apply to the declarations of bean properties.
 type MapResult struct {
 	JavaType     string
 	Imports      []string
@@ -47,12 +67,16 @@ type MapResult struct {
 	IsCollection bool
 	IsOptional   bool
 	ElementType  string // For collections/optionals
+
+	AnnotatedType        string
+	AnnotatedElementType string
+	AnnotationImports    []string
 }
 
 // MapType maps a GraphQL type reference to Java.
 func (tm *TypeMapper) MapType(typeRef *parser.TypeRef) (*MapResult, error) {
 	if typeRef == nil {
-		return &MapResult{JavaType: "Object"}, nil
+		return &MapResult{JavaType: "Object", AnnotatedType: "Object"}, nil
 	}
 
 	return tm.mapTypeInternal(typeRef, true)
@@ -71,9 +95,26 @@ func (tm *TypeMapper) mapTypeInternal(typeRef *parser.TypeRef, topLevel bool) (*
 
 		// Box primitive element types
 		elemType := elemResult.JavaType
+		annotatedType := elemResult.AnnotatedType
 		if elemResult.IsPrimitive {
 			elemType = BoxType(elemType)
+			annotatedType = BoxType(annotatedType)
+		}
+		annotationImports := elemResult.AnnotationImports
+
+		// Type-use nullness annotations also annotate the elements
+		if tm.nullability.IsTypeUse() {
+			annotation, imports := tm.nullability.GenerateAnnotation(typeRef.Elem.NonNull)
+			annotatedType = annotations.AnnotateType(annotatedType, annotation)
+			annotationImports = append(annotationImports, imports...)
+		}
+
+		// Container-element constraints
+		constraints, imports := tm.validation.GenerateElementAnnotations(typeRef.Elem, tm.IsGeneratedType)
+		for i := len(constraints) - 1; i >= 0; i-- {
+			annotatedType = annotations.AnnotateType(annotatedType, constraints[i])
 		}
+		annotationImports = append(annotationImports, imports...)
 
 		collectionType := tm.config.Java.CollectionType
 		collectionInfo := GetCollectionInfo(collectionType)
@@ -83,6 +124,10 @@ func (tm *TypeMapper) mapTypeInternal(typeRef *parser.TypeRef, topLevel bool) (*
 			Imports:      collectionInfo.Imports,
 			IsCollection: true,
 			ElementType:  elemType,
+
+			AnnotatedType:        FormatCollectionType(collectionType, annotatedType),
+			AnnotatedElementType: annotatedType,
+			AnnotationImports:    annotationImports,
 		}
 
 		// Add element type imports
@@ -115,6 +160,7 @@ func (tm *TypeMapper) mapTypeInternal(typeRef *parser.TypeRef, topLevel bool) (*
 			}
 		}
 	}
+	result.AnnotatedType = result.JavaType
 
 	return result, nil
 }
@@ -124,7 +170,7 @@ func (tm *TypeMapper) mapNamedType(name string) (*MapResult, error) {
 	if scalar, ok := tm.customScalars[name]; ok {
 		return &MapResult{
 			JavaType:    scalar.JavaType,
-			Imports:     scalar.Imports,
+			Imports:     withLangImports(scalar.JavaType, scalar.Imports),
 			IsPrimitive: scalar.PrimitiveType != "",
 		}, nil
 	}
@@ -133,17 +179,34 @@ func (tm *TypeMapper) mapNamedType(name string) (*MapResult, error) {
 	if scalar, ok := tm.builtinScalars[name]; ok {
 		return &MapResult{
 			JavaType:    scalar.JavaType,
-			Imports:     scalar.Imports,
+			Imports:     withLangImports(scalar.JavaType, scalar.Imports),
 			IsPrimitive: false, // Use wrapper by default
 		}, nil
 	}
 
+	// Schema types named like a common scalar, e.g. a Date object type, are
+	// not scalars
+	if typeDef, ok := tm.schemaTypes[name]; ok && typeDef.Kind != parser.TypeKindScalar {
+		return &MapResult{
+			JavaType: tm.getJavaTypeName(typeDef),
+		}, nil
+	}
+
 	// Check common scalars
 	commonScalars := CommonScalars()
 	if scalar, ok := commonScalars[name]; ok {
 		return &MapResult{
 			JavaType:    scalar.JavaType,
-			Imports:     scalar.Imports,
+			Imports:     withLangImports(scalar.JavaType, scalar.Imports),
+			IsPrimitive: false,
+		}, nil
+	}
+
+	// Check federation scalars
+	if scalar, ok := FederationScalars()[name]; ok {
+		return &MapResult{
+			JavaType:    scalar.JavaType,
+			Imports:     withLangImports(scalar.JavaType, scalar.Imports),
 			IsPrimitive: false,
 		}, nil
 	}
@@ -161,7 +224,49 @@ func (tm *TypeMapper) mapNamedType(name string) (*MapResult, error) {
 	}, nil
 }
 
+// IsGeneratedType returns true if a schema type is generated as a class or
+// interface whose instances may carry constraints: objects, inputs,
+// interfaces and unions.
+func (tm *TypeMapper) IsGeneratedType(name string) bool {
+	typeDef, ok := tm.schemaTypes[name]
+	if !ok {
+		return false
+	}
+	switch typeDef.Kind {
+	case parser.TypeKindObject, parser.TypeKindInputObject, parser.TypeKindInterface, parser.TypeKindUnion:
+		return true
+	}
+	return false
+}
+
+// javaLangTypes are the java.lang types scalars are commonly mapped to.
+var javaLangTypes = map[string]bool{
+	"Boolean": true, "Byte": true, "Character": true, "Double": true, "Float": true,
+	"Integer": true, "Long": true, "Number": true, "Object": true, "Short": true,
+	"String": true, "Void": true,
+}
+
+// withLangImports returns the imports of a scalar's Java type with the
+// java.lang types it refers to by simple name added. They are never
+// imported, but are qualified if a generated type has the same name.
+func withLangImports(javaType string, imports []string) []string {
+	result := append([]string(nil), imports...)
+	words := strings.FieldsFunc(javaType, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.'
+	})
+	for _, word := range words {
+		if javaLangTypes[word] {
+			result = append(result, "java.lang."+word)
+		}
+	}
+	return result
+}
+
 func (tm *TypeMapper) getJavaTypeName(typeDef *parser.TypeDef) string {
+	if name, ok := tm.typeNames[typeDef.Name]; ok {
+		return name
+	}
+
 	// Check for @javaName directive
 	if javaName := parser.ExtractJavaNameDirective(typeDef.Directives); javaName != nil {
 		return javaName.Name
@@ -223,8 +328,9 @@ func (tm *TypeMapper) MapFieldType(field *parser.FieldDef) (*MapResult, error) {
 	// Check for @javaType directive
 	if javaType := parser.ExtractJavaTypeDirective(field.Directives); javaType != nil {
 		return &MapResult{
-			JavaType: javaType.Type,
-			Imports:  javaType.Imports,
+			JavaType:      javaType.Type,
+			Imports:       javaType.Imports,
+			AnnotatedType: javaType.Type,
 		}, nil
 	}
 
@@ -240,23 +346,30 @@ func (tm *TypeMapper) MapFieldType(field *parser.FieldDef) (*MapResult, error) {
 	if collectionOverride != nil && result.IsCollection {
 		collectionInfo := GetCollectionInfo(collectionOverride.Type)
 		result.JavaType = FormatCollectionType(collectionOverride.Type, result.ElementType)
-		result.Imports = collectionInfo.Imports
-		result.Imports = append(result.Imports, tm.getElementImports(result.ElementType)...)
+		result.AnnotatedType = FormatCollectionType(collectionOverride.Type, result.AnnotatedElementType)
+		result.Imports = append(collectionInfo.Imports,
+			withoutImports(result.Imports, GetCollectionImports(tm.config.Java.CollectionType))...)
 	}
 
 	return result, nil
 }
 
-func (tm *TypeMapper) getElementImports(elementType string) []string {
-	// Check if element type needs imports
-	if scalar, ok := tm.customScalars[elementType]; ok {
-		return scalar.Imports
-	}
-	commonScalars := CommonScalars()
-	if scalar, ok := commonScalars[elementType]; ok {
-		return scalar.Imports
+// withoutImports returns imports without the given ones.
+func withoutImports(imports, remove []string) []string {
+	var result []string
+	for _, imp := range imports {
+		keep := true
+		for _, r := range remove {
+			if imp == r {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			result = append(result, imp)
+		}
 	}
-	return nil
+	return result
 }
 
 // ValidateMapping validates that a type can be mapped.
@@ -281,14 +394,62 @@ func (tm *TypeMapper) ValidateMapping(typeRef *parser.TypeRef) error {
 	if _, ok := CommonScalars()[name]; ok {
 		return nil
 	}
-	if _, ok := tm.schemaTypes[name]; ok {
+	if _, ok := FederationScalars()[name]; ok {
+		return nil
+	}
+	if typeDef, ok := tm.schemaTypes[name]; ok {
+		if typeDef.Kind == parser.TypeKindScalar {
+			// Custom scalars without a mapping become a class of the same name
+			return errors.NewTypeMappingError(
+				fmt.Sprintf("scalar %s has no Java mapping, will use as-is", name),
+				nil,
+			).WithSourceType(name)
+		}
 		return nil
 	}
 
 	// Unknown type - this is a warning, not an error
 	// as it might be defined elsewhere or be valid
 	return errors.NewTypeMappingError(
-		"unknown type, will use as-is",
+		fmt.Sprintf("unknown type %s, will use as-is", name),
 		nil,
 	).WithSourceType(name)
 }
+
+// narrowingMappings lists, per GraphQL scalar, the Java types that cannot
+// represent every value of the scalar.
+var narrowingMappings = map[string][]string{
+	"Float":      {"float", "Float"},
+	"Int":        {"short", "Short", "byte", "Byte"},
+	"Long":       {"int", "Integer", "short", "Short", "byte", "Byte"},
+	"BigInteger": {"long", "Long", "int", "Integer"},
+	"BigDecimal": {"double", "Double", "float", "Float"},
+	"Decimal":    {"double", "Double", "float", "Float"},
+}
+
+// ValidatePrecision checks that the Java type a field was mapped to can hold
+// every value of its GraphQL scalar. It returns a TypeMappingError for lossy
+// mappings, e.g. Float mapped to float or a decimal scalar mapped to double.
+func (tm *TypeMapper) ValidatePrecision(field *parser.FieldDef, result *MapResult) error {
+	if field.Type == nil || result == nil {
+		return nil
+	}
+
+	javaType := result.JavaType
+	if result.IsCollection {
+		javaType = result.ElementType
+	} else if result.IsOptional {
+		javaType = strings.TrimSuffix(strings.TrimPrefix(javaType, "Optional<"), ">")
+	}
+
+	scalar := field.Type.NamedType()
+	for _, narrower := range narrowingMappings[scalar] {
+		if javaType == narrower {
+			return errors.NewTypeMappingError(
+				fmt.Sprintf("%s mapped to %s loses precision", scalar, javaType),
+				nil,
+			).WithSourceType(scalar).WithTargetType(javaType)
+		}
+	}
+	return nil
+}
@@ -198,6 +198,41 @@ func TestTypeMapper_MapType_SchemaTypes(t *testing.T) {
 	assert.Equal(t, "User", result.JavaType)
 }
 
+func TestTypeMapper_MapType_SchemaTypeNamedLikeScalar(t *testing.T) {
+	cfg := config.DefaultConfig()
+	tm := NewTypeMapper(cfg)
+	tm.SetSchemaTypes(map[string]*parser.TypeDef{
+		"Date": {Name: "Date", Kind: parser.TypeKindObject},
+		"UUID": {Name: "UUID", Kind: parser.TypeKindScalar},
+	})
+
+	result, err := tm.MapType(&parser.TypeRef{Name: "Date", NonNull: true})
+	require.NoError(t, err)
+	assert.Equal(t, "Date", result.JavaType)
+	assert.Empty(t, result.Imports)
+
+	result, err = tm.MapType(&parser.TypeRef{Name: "UUID", NonNull: true})
+	require.NoError(t, err)
+	assert.Equal(t, "UUID", result.JavaType)
+	assert.Equal(t, []string{"java.util.UUID"}, result.Imports)
+}
+
+func TestTypeMapper_MapType_JavaLangImports(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TypeMappings.Scalars = map[string]config.ScalarMapping{
+		"JSON": {JavaType: "Map<String, Object>", Imports: []string{"java.util.Map"}},
+	}
+	tm := NewTypeMapper(cfg)
+
+	result, err := tm.MapType(&parser.TypeRef{Name: "JSON"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"java.util.Map", "java.lang.String", "java.lang.Object"}, result.Imports)
+
+	result, err = tm.MapType(&parser.TypeRef{Name: "Int"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"java.lang.Integer"}, result.Imports)
+}
+
 func TestTypeMapper_MapType_NilTypeRef(t *testing.T) {
 	cfg := config.DefaultConfig()
 	tm := NewTypeMapper(cfg)
@@ -255,6 +290,57 @@ func TestTypeMapper_MapFieldType_WithCollectionDirective(t *testing.T) {
 	assert.Equal(t, "Set<String>", result.JavaType)
 }
 
+func TestTypeMapper_MapType_NullabilityAnnotations(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.NullableHandling = config.NullableAnnotation
+	cfg.Java.Nullability.NonNull = true
+	tm := NewTypeMapper(cfg)
+
+	// [[String]!]
+	typeRef := &parser.TypeRef{
+		Elem: &parser.TypeRef{
+			Elem:    &parser.TypeRef{Name: "String"},
+			NonNull: true,
+		},
+	}
+
+	result, err := tm.MapType(typeRef)
+	require.NoError(t, err)
+	assert.Equal(t, "List<List<String>>", result.JavaType)
+	assert.Equal(t, "List<@NonNull List<@Nullable String>>", result.AnnotatedType)
+	assert.NotContains(t, result.Imports, "org.jspecify.annotations.Nullable")
+	assert.Contains(t, result.AnnotationImports, "org.jspecify.annotations.Nullable")
+	assert.Contains(t, result.AnnotationImports, "org.jspecify.annotations.NonNull")
+
+	// Declaration annotations cannot annotate elements
+	cfg.Java.Nullability.Library = config.NullabilityJSR305
+	result, err = tm.MapType(typeRef)
+	require.NoError(t, err)
+	assert.Equal(t, "List<List<String>>", result.JavaType)
+	assert.Equal(t, "List<List<String>>", result.AnnotatedType)
+}
+
+func TestTypeMapper_MapFieldType_CollectionDirectiveKeepsElementImports(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.NullableHandling = config.NullableAnnotation
+	tm := NewTypeMapper(cfg)
+
+	field := &parser.FieldDef{
+		Name: "ids",
+		Type: &parser.TypeRef{Elem: &parser.TypeRef{Name: "UUID"}},
+		Directives: []*parser.DirectiveDef{
+			{Name: "collection", Arguments: map[string]interface{}{"type": "Set"}},
+		},
+	}
+
+	result, err := tm.MapFieldType(field)
+	require.NoError(t, err)
+	assert.Equal(t, "Set<UUID>", result.JavaType)
+	assert.Equal(t, "Set<@Nullable UUID>", result.AnnotatedType)
+	assert.ElementsMatch(t, []string{"java.util.Set", "java.util.HashSet", "java.util.UUID"}, result.Imports)
+	assert.Equal(t, []string{"org.jspecify.annotations.Nullable"}, result.AnnotationImports)
+}
+
 func TestTypeMapper_ValidateMapping_KnownType(t *testing.T) {
 	cfg := config.DefaultConfig()
 	tm := NewTypeMapper(cfg)
@@ -354,3 +440,97 @@ func TestGetOptionalInfo(t *testing.T) {
 	info := GetOptionalInfo()
 	assert.Contains(t, info.Imports, "java.util.Optional")
 }
+
+func TestTypeMapper_FederationScalars(t *testing.T) {
+	cfg := config.DefaultConfig()
+	tm := NewTypeMapper(cfg)
+
+	result, err := tm.MapType(&parser.TypeRef{Name: "_Any", NonNull: true})
+	require.NoError(t, err)
+	assert.Equal(t, "Map<String, Object>", result.JavaType)
+	assert.Contains(t, result.Imports, "java.util.Map")
+
+	result, err = tm.MapType(&parser.TypeRef{Name: "FieldSet", NonNull: true})
+	require.NoError(t, err)
+	assert.Equal(t, "String", result.JavaType)
+
+	for _, name := range []string{"_Any", "FieldSet", "_FieldSet", "link__Import"} {
+		assert.NoError(t, tm.ValidateMapping(&parser.TypeRef{Name: name}), name)
+	}
+}
+
+func TestTypeMapper_ValidateMapping_UnmappedScalar(t *testing.T) {
+	cfg := config.DefaultConfig()
+	tm := NewTypeMapper(cfg)
+	tm.SetSchemaTypes(map[string]*parser.TypeDef{
+		"Money": {Name: "Money", Kind: parser.TypeKindScalar},
+		"User":  {Name: "User", Kind: parser.TypeKindObject},
+	})
+
+	err := tm.ValidateMapping(&parser.TypeRef{Name: "Money"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "scalar Money has no Java mapping")
+
+	assert.NoError(t, tm.ValidateMapping(&parser.TypeRef{Name: "User"}))
+
+	cfg.TypeMappings.Scalars["Money"] = config.ScalarMapping{JavaType: "BigDecimal"}
+	assert.NoError(t, NewTypeMapper(cfg).ValidateMapping(&parser.TypeRef{Name: "Money"}))
+}
+
+func TestTypeMapper_ValidatePrecision(t *testing.T) {
+	tests := []struct {
+		name     string
+		scalar   string
+		javaType string
+		lossy    bool
+	}{
+		{"default Float", "Float", "", false},
+		{"Float to float", "Float", "float", true},
+		{"Int to short", "Int", "Short", true},
+		{"decimal to double", "BigDecimal", "double", true},
+		{"decimal to BigDecimal", "BigDecimal", "BigDecimal", false},
+		{"BigInteger to long", "BigInteger", "long", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			if tt.javaType != "" {
+				cfg.TypeMappings.Scalars[tt.scalar] = config.ScalarMapping{JavaType: tt.javaType}
+			}
+			cfg.Java.NullableHandling = config.NullableAnnotation
+			tm := NewTypeMapper(cfg)
+
+			for _, typeRef := range []*parser.TypeRef{
+				{Name: tt.scalar},
+				{Elem: &parser.TypeRef{Name: tt.scalar, NonNull: true}},
+				{Elem: &parser.TypeRef{Name: tt.scalar}},
+			} {
+				field := &parser.FieldDef{Name: "value", Type: typeRef}
+				result, err := tm.MapFieldType(field)
+				require.NoError(t, err)
+
+				err = tm.ValidatePrecision(field, result)
+				if tt.lossy {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTypeMapper_ValidatePrecision_Optional(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Java.NullableHandling = config.NullableOptional
+	cfg.TypeMappings.Scalars["Float"] = config.ScalarMapping{JavaType: "Float"}
+	tm := NewTypeMapper(cfg)
+
+	field := &parser.FieldDef{Name: "weight", Type: &parser.TypeRef{Name: "Float"}}
+	result, err := tm.MapFieldType(field)
+	require.NoError(t, err)
+	require.Equal(t, "Optional<Float>", result.JavaType)
+
+	assert.Error(t, tm.ValidatePrecision(field, result))
+}
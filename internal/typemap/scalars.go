@@ -111,6 +111,28 @@ func CommonScalars() map[string]ScalarInfo {
 	}
 }
 
+// FederationScalars returns mappings for the Apollo Federation scalars.
+func FederationScalars() map[string]ScalarInfo {
+	return map[string]ScalarInfo{
+		"_Any": {
+			JavaType: "Map<String, Object>",
+			Imports:  []string{"java.util.Map"},
+		},
+		"FieldSet": {
+			JavaType: "String",
+			Imports:  nil,
+		},
+		"_FieldSet": {
+			JavaType: "String",
+			Imports:  nil,
+		},
+		"link__Import": {
+			JavaType: "String",
+			Imports:  nil,
+		},
+	}
+}
+
 // PrimitiveToBoxed maps primitive types to their boxed equivalents.
 var PrimitiveToBoxed = map[string]string{
 	"int":     "Integer",
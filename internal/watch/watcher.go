@@ -0,0 +1,153 @@
+// Package watch provides a polling file watcher used by the watch mode.
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Default timings for the watcher.
+const (
+	DefaultInterval = 300 * time.Millisecond
+	DefaultDebounce = 200 * time.Millisecond
+)
+
+// fileState is the observed state of a watched file.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Watcher polls a set of files and glob patterns for changes.
+// Polling is used instead of OS notifications so that editors which replace
+// files on save and newly created files matching an include glob are picked
+// up reliably on every platform.
+type Watcher struct {
+	patterns []string
+	interval time.Duration
+	debounce time.Duration
+	state    map[string]fileState
+}
+
+// NewWatcher creates a watcher for the given paths and glob patterns.
+func NewWatcher(patterns []string) *Watcher {
+	w := &Watcher{
+		interval: DefaultInterval,
+		debounce: DefaultDebounce,
+	}
+	w.SetPatterns(patterns)
+	return w
+}
+
+// SetInterval sets the polling interval.
+func (w *Watcher) SetInterval(interval time.Duration) {
+	w.interval = interval
+}
+
+// SetDebounce sets how long the files must stay unchanged before a change
+// is reported.
+func (w *Watcher) SetDebounce(debounce time.Duration) {
+	w.debounce = debounce
+}
+
+// SetPatterns replaces the watched paths and patterns, e.g. after the config
+// file changed the schema includes. The current file state is re-captured so
+// that the replacement itself is not reported as a change.
+func (w *Watcher) SetPatterns(patterns []string) {
+	w.patterns = patterns
+	w.state = w.snapshot()
+}
+
+// Files returns the currently matched files, sorted.
+func (w *Watcher) Files() []string {
+	files := make([]string, 0, len(w.state))
+	for path := range w.state {
+		files = append(files, path)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Run polls until the context is cancelled and calls onChange with the
+// changed files after each debounced batch of changes.
+func (w *Watcher) Run(ctx context.Context, onChange func(changed []string)) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	pending := make(map[string]bool)
+	var lastChange time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			changed := w.Poll()
+			for _, path := range changed {
+				pending[path] = true
+			}
+			if len(changed) > 0 {
+				lastChange = now
+				continue
+			}
+			if len(pending) > 0 && now.Sub(lastChange) >= w.debounce {
+				batch := make([]string, 0, len(pending))
+				for path := range pending {
+					batch = append(batch, path)
+				}
+				sort.Strings(batch)
+				pending = make(map[string]bool)
+				onChange(batch)
+			}
+		}
+	}
+}
+
+// Poll checks all watched files once and returns the ones that were
+// created, modified or removed since the previous check.
+func (w *Watcher) Poll() []string {
+	current := w.snapshot()
+	var changed []string
+
+	for path, st := range current {
+		if prev, ok := w.state[path]; !ok || prev != st {
+			changed = append(changed, path)
+		}
+	}
+	for path := range w.state {
+		if _, ok := current[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	w.state = current
+	sort.Strings(changed)
+	return changed
+}
+
+func (w *Watcher) snapshot() map[string]fileState {
+	state := make(map[string]fileState)
+
+	for _, pattern := range w.patterns {
+		if pattern == "" {
+			continue
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil || len(matches) == 0 {
+			// Keep watching plain paths that do not exist yet
+			matches = []string{pattern}
+		}
+		for _, path := range matches {
+			info, err := os.Stat(path)
+			if err != nil || info.IsDir() {
+				continue
+			}
+			state[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+
+	return state
+}
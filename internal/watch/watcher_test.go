@@ -0,0 +1,108 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestWatcher_Poll_DetectsModification(t *testing.T) {
+	tmpDir := t.TempDir()
+	schemaPath := filepath.Join(tmpDir, "schema.graphql")
+	writeFile(t, schemaPath, "type A { id: ID }")
+
+	w := NewWatcher([]string{schemaPath})
+	assert.Empty(t, w.Poll())
+
+	writeFile(t, schemaPath, "type A { id: ID name: String }")
+	assert.Equal(t, []string{schemaPath}, w.Poll())
+	assert.Empty(t, w.Poll())
+}
+
+func TestWatcher_Poll_GlobPicksUpNewAndRemovedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	first := filepath.Join(tmpDir, "a.graphql")
+	writeFile(t, first, "type A { id: ID }")
+
+	w := NewWatcher([]string{filepath.Join(tmpDir, "*.graphql")})
+	assert.Equal(t, []string{first}, w.Files())
+
+	second := filepath.Join(tmpDir, "b.graphql")
+	writeFile(t, second, "type B { id: ID }")
+	assert.Equal(t, []string{second}, w.Poll())
+
+	require.NoError(t, os.Remove(first))
+	assert.Equal(t, []string{first}, w.Poll())
+}
+
+func TestWatcher_Poll_MissingPathAppears(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "gql2j.yaml")
+
+	w := NewWatcher([]string{configPath})
+	assert.Empty(t, w.Files())
+
+	writeFile(t, configPath, "java:\n  version: 17\n")
+	assert.Equal(t, []string{configPath}, w.Poll())
+}
+
+func TestWatcher_SetPatterns_DoesNotReportExistingFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	a := filepath.Join(tmpDir, "a.graphql")
+	b := filepath.Join(tmpDir, "b.graphql")
+	writeFile(t, a, "type A { id: ID }")
+	writeFile(t, b, "type B { id: ID }")
+
+	w := NewWatcher([]string{a})
+	w.SetPatterns([]string{a, b})
+
+	assert.Empty(t, w.Poll())
+	assert.Equal(t, []string{a, b}, w.Files())
+}
+
+func TestWatcher_Run_DebouncesChanges(t *testing.T) {
+	tmpDir := t.TempDir()
+	a := filepath.Join(tmpDir, "a.graphql")
+	b := filepath.Join(tmpDir, "b.graphql")
+	writeFile(t, a, "type A { id: ID }")
+	writeFile(t, b, "type B { id: ID }")
+
+	w := NewWatcher([]string{filepath.Join(tmpDir, "*.graphql")})
+	w.SetInterval(10 * time.Millisecond)
+	w.SetDebounce(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	batches := make(chan []string, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- w.Run(ctx, func(changed []string) {
+			batches <- changed
+		})
+	}()
+
+	writeFile(t, a, "type A { id: ID name: String }")
+	time.Sleep(20 * time.Millisecond)
+	writeFile(t, b, "type B { id: ID name: String }")
+
+	select {
+	case batch := <-batches:
+		assert.Equal(t, []string{a, b}, batch)
+	case <-ctx.Done():
+		t.Fatal("no change reported")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -53,6 +53,11 @@ type Result struct {
 	// Errors are any errors that occurred during generation.
 	Errors []error
 
+	// Warnings are problems that did not stop generation, such as unmapped
+	// scalars, ignored directive arguments, lossy type mappings and
+	// references to skipped types.
+	Warnings []error
+
 	// Stats contains generation statistics.
 	Stats Stats
 }
@@ -108,7 +113,8 @@ func Generate(opts Options) (*Result, error) {
 
 	// Convert to public types
 	result := &Result{
-		Errors: genResult.Errors,
+		Errors:   genResult.Errors,
+		Warnings: genResult.Warnings,
 	}
 
 	for _, f := range genResult.Files {
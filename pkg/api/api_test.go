@@ -390,3 +390,58 @@ type SkippedType @gql2jSkip {
 	require.NotNil(t, userEntityFile, "UserEntity.java should be generated")
 	assert.Contains(t, userEntityFile.Content, "public class UserEntity")
 }
+
+func TestGenerate_WithIntrospectionSchema(t *testing.T) {
+	opts := Options{
+		SchemaPath: filepath.Join("..", "..", "testdata", "schemas", "introspection.json"),
+		Package:    "com.test",
+	}
+
+	result, err := Generate(opts)
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range result.Files {
+		names = append(names, f.FileName)
+	}
+	assert.Contains(t, names, "User.java")
+	assert.Contains(t, names, "Role.java")
+	assert.Contains(t, names, "UserFilter.java")
+}
+
+func TestGenerate_WithTypeExtensionsAcrossIncludes(t *testing.T) {
+	dir := filepath.Join("..", "..", "testdata", "schemas", "extensions")
+	opts := Options{
+		SchemaPath: filepath.Join(dir, "base.graphql"),
+		IncludePatterns: []string{
+			filepath.Join(dir, "users.graphql"),
+			filepath.Join(dir, "posts.graphql"),
+		},
+		Package: "com.test",
+	}
+
+	result, err := Generate(opts)
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, f := range result.Files {
+		files[f.FileName] = f.Content
+	}
+
+	require.Contains(t, files, "Account.java")
+	assert.Contains(t, files["Account.java"], "private Role role;")
+	assert.Contains(t, files["Query.java"], "private List<Account> users;")
+	assert.Contains(t, files["Query.java"], "private List<Post> posts;")
+	assert.Contains(t, files["Role.java"], "GUEST;")
+}
+
+func TestGenerate_Warnings(t *testing.T) {
+	result, err := Generate(Options{
+		Schema:  "scalar Money\ntype Order { total: Money }",
+		Package: "com.test",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0].Error(), "scalar Money has no Java mapping")
+}